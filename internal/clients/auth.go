@@ -28,8 +28,8 @@ type ResourceManagerAccount struct {
 	SkipResourceProviderRegistration bool
 }
 
-func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, subscriptionId string, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
-	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, config, config.Environment.MicrosoftGraph)
+func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, useAzureDeveloperCli bool, subscriptionId string, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
+	authorizer, err := newAuthorizer(ctx, config, useAzureDeveloperCli, config.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
 	}
@@ -136,3 +136,32 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// newAuthorizer returns an Authorizer for the specified API using the configured authentication methods. When
+// enabled, the Azure Developer CLI is attempted after the other authentication methods but before the Azure CLI.
+func newAuthorizer(ctx context.Context, config auth.Credentials, useAzureDeveloperCli bool, api environments.Api) (auth.Authorizer, error) {
+	if !useAzureDeveloperCli {
+		return auth.NewAuthorizerFromCredentials(ctx, config, api)
+	}
+
+	withoutAzureCli := config
+	withoutAzureCli.EnableAuthenticatingUsingAzureCLI = false
+	if authorizer, err := auth.NewAuthorizerFromCredentials(ctx, withoutAzureCli, api); err == nil {
+		return authorizer, nil
+	}
+
+	authorizer, err := NewAzureDeveloperCliAuthorizer(ctx, AzureDeveloperCliAuthorizerOptions{
+		Api:          api,
+		TenantId:     config.TenantID,
+		AuxTenantIds: config.AuxiliaryTenantIDs,
+	})
+	if err != nil {
+		if config.EnableAuthenticatingUsingAzureCLI {
+			log.Printf("[DEBUG] Falling back to the Azure CLI since the Azure Developer CLI Authorizer could not be configured: %+v", err)
+			return auth.NewAuthorizerFromCredentials(ctx, config, api)
+		}
+		return nil, fmt.Errorf("could not configure Azure Developer CLI Authorizer: %+v", err)
+	}
+
+	return authorizer, nil
+}
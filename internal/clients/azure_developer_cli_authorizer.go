@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"golang.org/x/oauth2"
+)
+
+type AzureDeveloperCliAuthorizerOptions struct {
+	// Api describes the Azure API being used
+	Api environments.Api
+
+	// TenantId is the tenant to authenticate against, when not specified the default tenant for the
+	// signed-in account is used
+	TenantId string
+
+	// AuxTenantIds lists additional tenants to authenticate against, currently only
+	// used for Resource Manager when auxiliary tenants are needed.
+	AuxTenantIds []string
+}
+
+// NewAzureDeveloperCliAuthorizer returns an Authorizer which authenticates using the Azure Developer CLI (`azd`).
+func NewAzureDeveloperCliAuthorizer(ctx context.Context, options AzureDeveloperCliAuthorizerOptions) (auth.Authorizer, error) {
+	if _, err := exec.LookPath("azd"); err != nil {
+		return nil, fmt.Errorf("could not find the Azure Developer CLI (`azd`) on the PATH: %+v", err)
+	}
+
+	authorizer := &AzureDeveloperCliAuthorizer{
+		Api:                options.Api,
+		TenantID:           options.TenantId,
+		AuxiliaryTenantIDs: options.AuxTenantIds,
+	}
+
+	// acquire a token up-front to confirm that the Azure Developer CLI is signed in, so that this fails early
+	if _, err := authorizer.Token(ctx, &http.Request{}); err != nil {
+		return nil, err
+	}
+
+	return auth.NewCachedAuthorizer(authorizer)
+}
+
+var _ auth.Authorizer = &AzureDeveloperCliAuthorizer{}
+
+// AzureDeveloperCliAuthorizer is an Authorizer which supports the Azure Developer CLI.
+type AzureDeveloperCliAuthorizer struct {
+	Api environments.Api
+
+	// TenantID is the specified tenant ID, if any
+	TenantID string
+
+	// AuxiliaryTenantIDs is an optional list of tenant IDs for which to obtain additional tokens
+	AuxiliaryTenantIDs []string
+}
+
+type azureDeveloperCliToken struct {
+	Token     string `json:"token"`
+	ExpiresOn string `json:"expiresOn"`
+}
+
+// Token returns an access token using the Azure Developer CLI as an authentication mechanism.
+func (a *AzureDeveloperCliAuthorizer) Token(ctx context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return a.tokenForTenant(ctx, a.TenantID)
+}
+
+// AuxiliaryTokens returns additional tokens for auxiliary tenant IDs, for use in multi-tenant scenarios
+func (a *AzureDeveloperCliAuthorizer) AuxiliaryTokens(ctx context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	tokens := make([]*oauth2.Token, 0)
+	for _, tenantId := range a.AuxiliaryTenantIDs {
+		token, err := a.tokenForTenant(ctx, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (a *AzureDeveloperCliAuthorizer) tokenForTenant(ctx context.Context, tenantId string) (*oauth2.Token, error) {
+	scope, err := environments.Scope(a.Api)
+	if err != nil {
+		return nil, fmt.Errorf("determining scope for %q: %+v", a.Api.Name(), err)
+	}
+
+	azdArgs := []string{"auth", "token", "--output", "json", "--scope", *scope}
+	if tenantId != "" {
+		azdArgs = append(azdArgs, "--tenant-id", tenantId)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "azd", azdArgs...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("obtaining an access token from the Azure Developer CLI: %s", message)
+	}
+
+	var token azureDeveloperCliToken
+	if err := json.Unmarshal(stdout.Bytes(), &token); err != nil {
+		return nil, fmt.Errorf("parsing the access token returned by the Azure Developer CLI: %+v", err)
+	}
+	if token.Token == "" {
+		return nil, fmt.Errorf("the Azure Developer CLI returned an empty access token")
+	}
+
+	var expiry time.Time
+	if token.ExpiresOn != "" {
+		if expiry, err = time.Parse(time.RFC3339, token.ExpiresOn); err != nil {
+			return nil, fmt.Errorf("parsing `expiresOn` value %q for the Azure Developer CLI token: %+v", token.ExpiresOn, err)
+		}
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.Token,
+		Expiry:      expiry,
+		TokenType:   "Bearer",
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	aadb2c_v2021_04_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview"
 	analysisservices_v2017_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01"
+	apicenter_2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01"
 	azurestackhci_v2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01"
 	datadog_v2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01"
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
@@ -29,6 +30,7 @@ import (
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
+	apiCenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/client"
 	apiManagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/client"
 	appConfiguration "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/client"
 	applicationInsights "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/client"
@@ -41,6 +43,7 @@ import (
 	automation "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/client"
 	azureStackHCI "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/client"
 	batch "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/client"
+	billing "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/client"
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
@@ -121,11 +124,13 @@ import (
 	privatedns "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/client"
 	dnsresolver "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/client"
 	purview "github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/client"
+	quota "github.com/hashicorp/terraform-provider-azurerm/internal/services/quota/client"
 	recoveryServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/client"
 	redhatopenshift "github.com/hashicorp/terraform-provider-azurerm/internal/services/redhatopenshift/client"
 	redis "github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/client"
 	redisenterprise "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/client"
 	relay "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/client"
+	reservations "github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/client"
 	resource "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	search "github.com/hashicorp/terraform-provider-azurerm/internal/services/search/client"
 	securityCenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/client"
@@ -143,6 +148,7 @@ import (
 	storageMover "github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/client"
 	streamAnalytics "github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/client"
 	subscription "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/client"
+	support "github.com/hashicorp/terraform-provider-azurerm/internal/services/support/client"
 	synapse "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/client"
 	systemCenterVirtualMachineManager "github.com/hashicorp/terraform-provider-azurerm/internal/services/systemcentervirtualmachinemanager/client"
 	trafficManager "github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/client"
@@ -151,6 +157,7 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Client struct {
@@ -162,9 +169,14 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// ResourceTypes contains the Resource Types supported by the Provider, which is used to determine which
+	// of these are able to import a given Resource ID
+	ResourceTypes map[string]*pluginsdk.Resource
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
+	ApiCenter                         *apicenter_2024_03_01.Client
 	ApiManagement                     *apiManagement.Client
 	AppConfiguration                  *appConfiguration.Client
 	AppInsights                       *applicationInsights.Client
@@ -178,6 +190,7 @@ type Client struct {
 	Automation                        *automation.Client
 	AzureStackHCI                     *azurestackhci_v2024_01_01.Client
 	Batch                             *batch.Client
+	Billing                           *billing.Client
 	Blueprints                        *blueprints.Client
 	Bot                               *bot.Client
 	Cdn                               *cdn.Client
@@ -258,11 +271,13 @@ type Client struct {
 	PrivateDns                        *privatedns.Client
 	PrivateDnsResolver                *dnsresolver.Client
 	Purview                           *purview.Client
+	Quota                             *quota.AutoClient
 	RecoveryServices                  *recoveryServices.Client
 	RedHatOpenShift                   *redhatopenshift.Client
 	Redis                             *redis_2023_08_01.Client
 	RedisEnterprise                   *redisenterprise.Client
 	Relay                             *relay.Client
+	Reservations                      *reservations.Client
 	Resource                          *resource.Client
 	Search                            *search.Client
 	SecurityCenter                    *securityCenter.Client
@@ -278,6 +293,7 @@ type Client struct {
 	StorageMover                      *storageMover.Client
 	StreamAnalytics                   *streamAnalytics.Client
 	Subscription                      *subscription.Client
+	Support                           *support.Client
 	Sql                               *sql.Client
 	Synapse                           *synapse.Client
 	SystemCenterVirtualMachineManager *systemcentervirtualmachinemanager_2023_10_07.Client
@@ -314,6 +330,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.AnalysisServices, err = analysisServices.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for AnalysisServices: %+v", err)
 	}
+	if client.ApiCenter, err = apiCenter.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ApiCenter: %+v", err)
+	}
 	if client.ApiManagement, err = apiManagement.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ApiManagement: %+v", err)
 	}
@@ -353,6 +372,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Batch, err = batch.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Batch: %+v", err)
 	}
+	if client.Billing, err = billing.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Billing: %+v", err)
+	}
 	if client.Blueprints, err = blueprints.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for BluePrints: %+v", err)
 	}
@@ -585,6 +607,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Purview, err = purview.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Purview: %+v", err)
 	}
+	if client.Quota, err = quota.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Quota: %+v", err)
+	}
 	if client.RecoveryServices, err = recoveryServices.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for RecoveryServices: %+v", err)
 	}
@@ -600,6 +625,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Relay, err = relay.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Relay: %+v", err)
 	}
+	client.Reservations = reservations.NewClient(o)
 	if client.Resource, err = resource.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Resource: %+v", err)
 	}
@@ -646,6 +672,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Subscription, err = subscription.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Subscription: %+v", err)
 	}
+	client.Support = support.NewClient(o)
 
 	client.Synapse = synapse.NewClient(o)
 	if client.SystemCenterVirtualMachineManager, err = systemCenterVirtualMachineManager.NewClient(o); err != nil {
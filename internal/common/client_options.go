@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/sender"
@@ -52,6 +53,12 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// PollingInterval is the minimum interval between polls of a long-running operation, which can be
+	// overridden for a Resource Type using ResourcePollingIntervals - this applies to both the clients
+	// from hashicorp/go-azure-sdk and go-autorest, and to every kind of poller
+	PollingInterval          time.Duration
+	ResourcePollingIntervals map[string]time.Duration
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -73,6 +80,12 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	tracker := newLongRunningOperationTracker()
+	if o.PollingInterval > 0 || len(o.ResourcePollingIntervals) > 0 {
+		c.AppendRequestMiddleware(pollingIntervalMiddleware(tracker, pollingInterval(o.PollingInterval, o.ResourcePollingIntervals)))
+	}
+	c.AppendResponseMiddleware(longRunningOperationMiddleware(tracker))
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
 }
@@ -82,7 +95,7 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), withLongRunningOperations(newLongRunningOperationTracker(), pollingInterval(o.PollingInterval, o.ResourcePollingIntervals)))
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
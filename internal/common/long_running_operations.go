@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+type longRunningOperationPollType int
+
+const (
+	// pollOperationStatus is used when the URI returned in the `Azure-AsyncOperation` or `Location` header is polled
+	pollOperationStatus longRunningOperationPollType = iota
+
+	// pollProvisioningState is used when the resource itself is polled until its `provisioningState` is terminal
+	pollProvisioningState
+
+	// pollDeletion is used when the resource itself is polled until it's gone
+	pollDeletion
+)
+
+// longRunningOperationStaleAfter is how long a poll is tracked for without being polled again, after which it's assumed
+// that the poller has stopped (for example as the context was cancelled) and it's no longer tracked
+const longRunningOperationStaleAfter = 24 * time.Hour
+
+type longRunningOperationPoll struct {
+	pollType   longRunningOperationPollType
+	lastPolled time.Time
+}
+
+// operationStatus is the subset of the payload of a long-running operation (which exposes `status`) or of a resource
+// (which exposes `properties.provisioningState`) which describes how the operation is progressing
+type operationStatus struct {
+	Status          string   `json:"status"`
+	PercentComplete *float64 `json:"percentComplete"`
+	Properties      *struct {
+		ProvisioningState string   `json:"provisioningState"`
+		PercentComplete   *float64 `json:"percentComplete"`
+	} `json:"properties"`
+}
+
+func (s operationStatus) provisioningState() string {
+	if s.Properties == nil {
+		return ""
+	}
+	return s.Properties.ProvisioningState
+}
+
+// longRunningOperationTracker keeps track of the URIs which are being polled for the long-running operations started by
+// a client, regardless of which kind of poller (or which SDK) is used to poll them, so that these polls can be told
+// apart from any other requests to the same API.
+type longRunningOperationTracker struct {
+	lock  sync.Mutex
+	polls map[string]*longRunningOperationPoll
+	now   func() time.Time
+}
+
+func newLongRunningOperationTracker() *longRunningOperationTracker {
+	return &longRunningOperationTracker{
+		polls: make(map[string]*longRunningOperationPoll),
+		now:   time.Now,
+	}
+}
+
+func longRunningOperationPollKey(uri *url.URL) string {
+	// the query string is ignored since the api-version may differ between the request and the poll
+	return strings.ToLower(uri.Host + uri.Path)
+}
+
+// lastPolled returns when the request was last polled (or when the operation was started), if it's a poll of a
+// long-running operation being tracked
+func (t *longRunningOperationTracker) lastPolled(request *http.Request) (time.Time, bool) {
+	if request == nil || request.URL == nil || !strings.EqualFold(request.Method, http.MethodGet) {
+		return time.Time{}, false
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	poll, ok := t.polls[longRunningOperationPollKey(request.URL)]
+	if !ok {
+		return time.Time{}, false
+	}
+	return poll.lastPolled, true
+}
+
+// observe inspects the response to a request, tracking the URI to be polled when the request started a long-running
+// operation, and returning the status of the operation when the request was a poll of a tracked operation
+func (t *longRunningOperationTracker) observe(request *http.Request, response *http.Response) (*operationStatus, bool) {
+	if request == nil || request.URL == nil || response == nil {
+		return nil, false
+	}
+
+	switch strings.ToUpper(request.Method) {
+	case http.MethodGet:
+		return t.observePoll(request, response)
+
+	case http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete:
+		t.observeStart(request, response)
+	}
+
+	return nil, false
+}
+
+func (t *longRunningOperationTracker) observeStart(request *http.Request, response *http.Response) {
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusAccepted {
+		return
+	}
+
+	if v := response.Header.Get("Azure-AsyncOperation"); v != "" {
+		t.track(v, pollOperationStatus)
+		return
+	}
+
+	if v := response.Header.Get("Location"); v != "" && response.StatusCode != http.StatusOK {
+		t.track(v, pollOperationStatus)
+		return
+	}
+
+	if strings.EqualFold(request.Method, http.MethodDelete) {
+		t.track(request.URL.String(), pollDeletion)
+		return
+	}
+
+	if status := readOperationStatus(response); status != nil && !isTerminalOperationStatus(status.provisioningState()) {
+		t.track(request.URL.String(), pollProvisioningState)
+	}
+}
+
+func (t *longRunningOperationTracker) observePoll(request *http.Request, response *http.Response) (*operationStatus, bool) {
+	key := longRunningOperationPollKey(request.URL)
+
+	t.lock.Lock()
+	poll, ok := t.polls[key]
+	t.lock.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	status := readOperationStatus(response)
+	if status == nil {
+		status = &operationStatus{}
+	}
+
+	completed := true
+	if response.StatusCode == http.StatusOK || response.StatusCode == http.StatusCreated || response.StatusCode == http.StatusAccepted {
+		switch poll.pollType {
+		case pollOperationStatus:
+			if status.Status != "" {
+				completed = isTerminalOperationStatus(status.Status)
+			} else {
+				completed = response.StatusCode != http.StatusAccepted
+			}
+
+		case pollProvisioningState:
+			completed = isTerminalOperationStatus(status.provisioningState())
+
+		case pollDeletion:
+			// the resource still exists
+			completed = false
+		}
+	}
+
+	t.lock.Lock()
+	if completed {
+		delete(t.polls, key)
+	} else {
+		poll.lastPolled = t.now()
+	}
+	t.lock.Unlock()
+
+	return status, true
+}
+
+func (t *longRunningOperationTracker) track(uri string, pollType longRunningOperationPollType) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	for k, v := range t.polls {
+		if now.Sub(v.lastPolled) > longRunningOperationStaleAfter {
+			delete(t.polls, k)
+		}
+	}
+
+	t.polls[longRunningOperationPollKey(parsed)] = &longRunningOperationPoll{
+		pollType:   pollType,
+		lastPolled: now,
+	}
+}
+
+func isTerminalOperationStatus(input string) bool {
+	switch strings.ToLower(input) {
+	// an empty provisioningState is treated as terminal, since there's nothing to wait for
+	case "", "succeeded", "failed", "canceled", "cancelled":
+		return true
+	}
+	return false
+}
+
+// readOperationStatus reads the operation status from the JSON body of the response, leaving the body intact to be
+// read again by the caller
+func readOperationStatus(response *http.Response) *operationStatus {
+	if response.Body == nil || !strings.Contains(strings.ToLower(response.Header.Get("Content-Type")), "application/json") {
+		return nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	var status operationStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil
+	}
+	return &status
+}
+
+// longRunningOperationMiddleware tracks the long-running operations started by a hashicorp/go-azure-sdk client, logging
+// their progress as they're polled
+func longRunningOperationMiddleware(tracker *longRunningOperationTracker) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if status, ok := tracker.observe(request, response); ok {
+			logOperationProgress("AzureRM", request, status)
+		}
+		return response, nil
+	}
+}
+
+// withLongRunningOperations tracks the long-running operations started by a go-autorest client, logging their progress
+// as they're polled and waiting for at least the polling interval returned by `interval` between polls of these
+func withLongRunningOperations(tracker *longRunningOperationTracker, interval func(ctx context.Context) time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if err := waitForPollingInterval(r, tracker, interval); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(r)
+			if err == nil {
+				if status, ok := tracker.observe(r, resp); ok {
+					logOperationProgress("AzureRM", r, status)
+				}
+			}
+			return resp, err
+		})
+	}
+}
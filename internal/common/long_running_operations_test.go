@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestLongRunningOperationTracker(t *testing.T) {
+	resourceUri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example?api-version=2024-03-01"
+	operationUri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute/locations/westeurope/operations/abc?api-version=2024-03-01"
+
+	type step struct {
+		method     string
+		uri        string
+		statusCode int
+		header     http.Header
+		body       string
+
+		expectedPoll bool
+	}
+
+	testCases := []struct {
+		name          string
+		steps         []step
+		expectTracked []string
+	}{
+		{
+			name: "Azure-AsyncOperation header",
+			steps: []step{
+				{method: http.MethodPut, uri: resourceUri, statusCode: http.StatusCreated, header: http.Header{"Azure-Asyncoperation": []string{operationUri}}},
+				{method: http.MethodGet, uri: operationUri, statusCode: http.StatusOK, body: `{"status": "InProgress"}`, expectedPoll: true},
+			},
+			expectTracked: []string{operationUri},
+		},
+		{
+			name: "Azure-AsyncOperation completed",
+			steps: []step{
+				{method: http.MethodPut, uri: resourceUri, statusCode: http.StatusCreated, header: http.Header{"Azure-Asyncoperation": []string{operationUri}}},
+				{method: http.MethodGet, uri: operationUri, statusCode: http.StatusOK, body: `{"status": "Succeeded"}`, expectedPoll: true},
+				{method: http.MethodGet, uri: operationUri, statusCode: http.StatusOK, body: `{"status": "Succeeded"}`},
+			},
+		},
+		{
+			name: "Location header completed",
+			steps: []step{
+				{method: http.MethodPost, uri: resourceUri, statusCode: http.StatusAccepted, header: http.Header{"Location": []string{operationUri}}},
+				{method: http.MethodGet, uri: operationUri, statusCode: http.StatusAccepted, expectedPoll: true},
+				{method: http.MethodGet, uri: operationUri, statusCode: http.StatusNoContent, expectedPoll: true},
+			},
+		},
+		{
+			name: "provisioning state",
+			steps: []step{
+				{method: http.MethodPut, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Creating"}}`},
+				{method: http.MethodGet, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Creating"}}`, expectedPoll: true},
+			},
+			expectTracked: []string{resourceUri},
+		},
+		{
+			name: "provisioning state already terminal",
+			steps: []step{
+				{method: http.MethodPut, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Succeeded"}}`},
+				{method: http.MethodGet, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Succeeded"}}`},
+			},
+		},
+		{
+			name: "deletion",
+			steps: []step{
+				{method: http.MethodDelete, uri: resourceUri, statusCode: http.StatusAccepted},
+				{method: http.MethodGet, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Deleting"}}`, expectedPoll: true},
+				{method: http.MethodGet, uri: resourceUri, statusCode: http.StatusNotFound, expectedPoll: true},
+			},
+		},
+		{
+			name: "unrelated request",
+			steps: []step{
+				{method: http.MethodGet, uri: resourceUri, statusCode: http.StatusOK, body: `{"properties": {"provisioningState": "Creating"}}`},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.name)
+
+		tracker := newLongRunningOperationTracker()
+		for i, s := range tc.steps {
+			uri, _ := url.Parse(s.uri)
+			header := s.header
+			if header == nil {
+				header = http.Header{}
+			}
+			if s.body != "" {
+				header.Set("Content-Type", "application/json; charset=utf-8")
+			}
+
+			request := &http.Request{Method: s.method, URL: uri}
+			response := &http.Response{
+				StatusCode: s.statusCode,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(s.body)),
+			}
+
+			if _, polled := tracker.observe(request, response); polled != s.expectedPoll {
+				t.Fatalf("expected step %d to be a poll to be %t but got %t", i, s.expectedPoll, polled)
+			}
+
+			body, _ := io.ReadAll(response.Body)
+			if string(body) != s.body {
+				t.Fatalf("expected the body of step %d to be left intact, got %q", i, string(body))
+			}
+		}
+
+		if len(tracker.polls) != len(tc.expectTracked) {
+			t.Fatalf("expected %d polls to be tracked but got %d", len(tc.expectTracked), len(tracker.polls))
+		}
+		for _, v := range tc.expectTracked {
+			uri, _ := url.Parse(v)
+			if _, ok := tracker.polls[longRunningOperationPollKey(uri)]; !ok {
+				t.Fatalf("expected %q to be tracked", v)
+			}
+		}
+	}
+}
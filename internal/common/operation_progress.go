@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// logOperationProgress logs the progress of a long-running operation at INFO level each time it's polled, so that the
+// logs for resources which take a long time to provision (e.g. Kubernetes Clusters or Virtual Network Gateways) show
+// how the operation is progressing rather than nothing at all until it completes.
+//
+// This is only called for the polls of the long-running operations tracked by a longRunningOperationTracker, so other
+// requests (such as reading a resource during a refresh) aren't logged.
+func logOperationProgress(providerName string, request *http.Request, status *operationStatus) {
+	if message := operationProgressMessage(status); message != "" {
+		log.Printf("[INFO] %s: polling %q: %s", providerName, request.URL.Path, message)
+	}
+}
+
+func operationProgressMessage(status *operationStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	// the payload of a long-running operation exposes `status`, whereas a resource exposes `provisioningState`
+	var message string
+	percentComplete := status.PercentComplete
+	switch {
+	case status.Status != "":
+		message = fmt.Sprintf("long-running operation status is %q", status.Status)
+	case status.provisioningState() != "":
+		message = fmt.Sprintf("provisioning state is %q", status.provisioningState())
+	default:
+		return ""
+	}
+
+	if percentComplete == nil && status.Properties != nil {
+		percentComplete = status.Properties.PercentComplete
+	}
+	if percentComplete != nil {
+		message = fmt.Sprintf("%s (%.0f%% complete)", message, *percentComplete)
+	}
+
+	return message
+}
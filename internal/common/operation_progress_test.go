@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOperationProgressMessage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "long-running operation",
+			body:     `{"name": "abc", "status": "InProgress"}`,
+			expected: `long-running operation status is "InProgress"`,
+		},
+		{
+			name:     "long-running operation with percent complete",
+			body:     `{"name": "abc", "status": "InProgress", "percentComplete": 42.5}`,
+			expected: `long-running operation status is "InProgress" (42% complete)`,
+		},
+		{
+			name:     "resource",
+			body:     `{"id": "abc", "properties": {"provisioningState": "Updating"}}`,
+			expected: `provisioning state is "Updating"`,
+		},
+		{
+			name:     "resource with percent complete",
+			body:     `{"id": "abc", "properties": {"provisioningState": "Creating", "percentComplete": 80}}`,
+			expected: `provisioning state is "Creating" (80% complete)`,
+		},
+		{
+			name:     "no progress",
+			body:     `{"id": "abc", "properties": {}}`,
+			expected: "",
+		},
+		{
+			name:     "not json",
+			body:     `hello`,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.name)
+
+		var status *operationStatus
+		if err := json.Unmarshal([]byte(tc.body), &status); err != nil {
+			status = nil
+		}
+
+		if actual := operationProgressMessage(status); actual != tc.expected {
+			t.Fatalf("expected %q but got %q", tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+type pollingResourceTypeKey struct{}
+
+// WithPollingResourceType returns a copy of the context tagged with the Terraform Resource Type performing the requests,
+// which is used to look up the polling interval overridden for that Resource Type
+func WithPollingResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, pollingResourceTypeKey{}, resourceType)
+}
+
+func pollingResourceTypeFromContext(ctx context.Context) (string, bool) {
+	resourceType, ok := ctx.Value(pollingResourceTypeKey{}).(string)
+	return resourceType, ok
+}
+
+// pollingInterval returns a function which determines the polling interval to use for a request, based on the
+// Resource Type the context has been tagged with
+func pollingInterval(defaultInterval time.Duration, resourceIntervals map[string]time.Duration) func(ctx context.Context) time.Duration {
+	return func(ctx context.Context) time.Duration {
+		if resourceType, ok := pollingResourceTypeFromContext(ctx); ok {
+			if v, ok := resourceIntervals[resourceType]; ok {
+				return v
+			}
+		}
+		return defaultInterval
+	}
+}
+
+// waitForPollingInterval waits until at least the polling interval has passed since a long-running operation was last
+// polled (or was started) before it's polled again.
+//
+// This is done when sending the request rather than by the pollers themselves, since the pollers within
+// hashicorp/go-azure-sdk either use the `Retry-After` header or a fixed interval (and the pollers within go-autorest
+// only use the configured interval when no `Retry-After` header is returned) - so this is the only place where the
+// interval applies to every kind of poller. As such a longer interval returned by the API is still honoured.
+func waitForPollingInterval(request *http.Request, tracker *longRunningOperationTracker, interval func(ctx context.Context) time.Duration) error {
+	if request == nil {
+		return nil
+	}
+
+	ctx := request.Context()
+	wait := interval(ctx)
+	if wait <= 0 {
+		return nil
+	}
+
+	lastPolled, ok := tracker.lastPolled(request)
+	if !ok {
+		return nil
+	}
+
+	remaining := wait - tracker.now().Sub(lastPolled)
+	if remaining <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pollingIntervalMiddleware waits for at least the configured polling interval between polls of the long-running
+// operations started by a hashicorp/go-azure-sdk client
+func pollingIntervalMiddleware(tracker *longRunningOperationTracker, interval func(ctx context.Context) time.Duration) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := waitForPollingInterval(request, tracker, interval); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
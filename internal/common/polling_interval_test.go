@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestPollingInterval(t *testing.T) {
+	testCases := []struct {
+		name         string
+		resourceType string
+		expected     time.Duration
+	}{
+		{
+			name:     "no resource type",
+			expected: 30 * time.Second,
+		},
+		{
+			name:         "resource type override",
+			resourceType: "azurerm_linux_virtual_machine",
+			expected:     45 * time.Second,
+		},
+		{
+			name:         "resource type without override",
+			resourceType: "azurerm_resource_group",
+			expected:     30 * time.Second,
+		},
+	}
+
+	interval := pollingInterval(30*time.Second, map[string]time.Duration{
+		"azurerm_linux_virtual_machine": 45 * time.Second,
+	})
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.name)
+
+		ctx := context.TODO()
+		if tc.resourceType != "" {
+			ctx = WithPollingResourceType(ctx, tc.resourceType)
+		}
+
+		if actual := interval(ctx); actual != tc.expected {
+			t.Fatalf("expected the polling interval to be %s but got %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestWaitForPollingInterval(t *testing.T) {
+	pollUri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute/locations/westeurope/operations/abc"
+	resourceUri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example"
+
+	testCases := []struct {
+		name       string
+		method     string
+		uri        string
+		lastPolled time.Duration
+		expected   bool
+	}{
+		{
+			name:       "poll within the interval",
+			method:     http.MethodGet,
+			uri:        pollUri,
+			lastPolled: 10 * time.Second,
+			expected:   true,
+		},
+		{
+			name:       "poll after the interval",
+			method:     http.MethodGet,
+			uri:        pollUri,
+			lastPolled: 40 * time.Second,
+			expected:   false,
+		},
+		{
+			name:       "request which isn't a poll",
+			method:     http.MethodGet,
+			uri:        resourceUri,
+			lastPolled: 10 * time.Second,
+			expected:   false,
+		},
+		{
+			name:       "request which isn't a GET",
+			method:     http.MethodPut,
+			uri:        pollUri,
+			lastPolled: 10 * time.Second,
+			expected:   false,
+		},
+	}
+
+	interval := pollingInterval(30*time.Second, nil)
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.name)
+
+		now := time.Now()
+		tracker := newLongRunningOperationTracker()
+		tracker.now = func() time.Time {
+			return now.Add(-tc.lastPolled)
+		}
+		tracker.track(pollUri, pollOperationStatus)
+		tracker.now = func() time.Time {
+			return now
+		}
+
+		// a cancelled context returns immediately with an error if the request has to wait
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		uri, _ := url.Parse(tc.uri)
+		request := (&http.Request{Method: tc.method, URL: uri}).WithContext(ctx)
+
+		waited := waitForPollingInterval(request, tracker, interval) != nil
+		if waited != tc.expected {
+			t.Fatalf("expected the request to wait to be %t but got %t", tc.expected, waited)
+		}
+	}
+}
@@ -43,6 +43,7 @@ func Default() UserFeatures {
 		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: true,
+			MoveResourcesOnResourceGroupChange: false,
 		},
 		RecoveryServicesVault: RecoveryServicesVault{
 			RecoverSoftDeletedBackupProtectedVM: true,
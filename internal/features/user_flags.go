@@ -64,6 +64,7 @@ type LogAnalyticsWorkspaceFeatures struct {
 
 type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
+	MoveResourcesOnResourceGroupChange bool
 }
 
 type ApiManagementFeatures struct {
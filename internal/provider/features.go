@@ -275,6 +275,12 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  os.Getenv("TF_ACC") == "",
 					},
+
+					"move_resources_on_resource_group_change": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -558,6 +564,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
 				featuresMap.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
 			}
+			if v, ok := resourceGroupRaw["move_resources_on_resource_group_change"]; ok {
+				featuresMap.ResourceGroup.MoveResourcesOnResourceGroupChange = v.(bool)
+			}
 		}
 	}
 
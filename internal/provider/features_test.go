@@ -148,7 +148,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
-							"prevent_deletion_if_contains_resources": true,
+							"prevent_deletion_if_contains_resources":  true,
+							"move_resources_on_resource_group_change": true,
 						},
 					},
 					"recovery_services_vaults": []interface{}{
@@ -231,6 +232,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
+					MoveResourcesOnResourceGroupChange: true,
 				},
 				RecoveryServicesVault: features.RecoveryServicesVault{
 					RecoverSoftDeletedBackupProtectedVM: true,
@@ -323,7 +325,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
-							"prevent_deletion_if_contains_resources": false,
+							"prevent_deletion_if_contains_resources":  false,
+							"move_resources_on_resource_group_change": false,
 						},
 					},
 					"recovery_services_vaults": []interface{}{
@@ -406,6 +409,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
+					MoveResourcesOnResourceGroupChange: false,
 				},
 				RecoveryServicesVault: features.RecoveryServicesVault{
 					RecoverSoftDeletedBackupProtectedVM: false,
@@ -1290,6 +1294,25 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Move Resources On Resource Group Change Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources":  true,
+							"move_resources_on_resource_group_change": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+					MoveResourcesOnResourceGroupChange: true,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
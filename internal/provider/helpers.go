@@ -4,13 +4,19 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
 
 // logEntry avoids log entries showing up in test output
@@ -39,6 +45,40 @@ func decodeCertificate(clientCertificate string) ([]byte, error) {
 	return pfx, nil
 }
 
+// getClientCertificateFromKeyVault retrieves the Client Certificate from the specified Key Vault Secret, authenticating
+// to Key Vault using the Managed Identity available in the environment the Provider is running in
+func getClientCertificateFromKeyVault(ctx context.Context, d *pluginsdk.ResourceData, env environments.Environment, secretId string) ([]byte, error) {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return nil, err
+	}
+	if id.NestedItemType != keyVaultParse.NestedItemTypeSecret {
+		return nil, fmt.Errorf("expected `client_certificate_key_vault_secret_id` to be the ID of a Key Vault Secret but got %q", secretId)
+	}
+
+	authorizer, err := auth.NewManagedIdentityAuthorizer(ctx, auth.ManagedIdentityAuthorizerOptions{
+		Api:                           env.KeyVault,
+		CustomManagedIdentityEndpoint: d.Get("msi_endpoint").(string),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Identity authorizer to retrieve the Client Certificate from %s: %+v", id, err)
+	}
+
+	client := dataplane.New()
+	client.Authorizer = authWrapper.AutorestAuthorizer(authorizer).BearerAuthorizerCallback()
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate from %s: %+v", id, err)
+	}
+	if resp.Value == nil || *resp.Value == "" {
+		return nil, fmt.Errorf("retrieving the Client Certificate from %s: `value` was nil", id)
+	}
+
+	// certificates stored in Key Vault are exposed as a base64 encoded PKCS#12 bundle through the Secret
+	return decodeCertificate(*resp.Value)
+}
+
 func getOidcToken(d *pluginsdk.ResourceData) (*string, error) {
 	idToken := strings.TrimSpace(d.Get("oidc_token").(string))
 
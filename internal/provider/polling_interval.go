@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// validatePollingInterval validates that the value is a duration (e.g. `30s` or `1m`) of at least one second, since
+// the polling interval is applied in whole seconds
+func validatePollingInterval(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if v == "" {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid duration (e.g. `30s` or `1m`): %+v", k, err)}
+	}
+
+	if duration < time.Second {
+		return nil, []error{fmt.Errorf("expected %q to be at least `1s`, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+func expandPollingInterval(input string) time.Duration {
+	if input == "" {
+		return 0
+	}
+
+	// the value has already been validated
+	duration, _ := time.ParseDuration(input)
+	return duration
+}
+
+func expandResourcePollingIntervals(input map[string]interface{}, resources map[string]*schema.Resource) (map[string]time.Duration, error) {
+	output := make(map[string]time.Duration)
+	for resourceType, v := range input {
+		if _, ok := resources[resourceType]; !ok {
+			return nil, fmt.Errorf("`resource_polling_intervals` contains the unknown Resource Type %q", resourceType)
+		}
+
+		output[resourceType] = expandPollingInterval(v.(string))
+	}
+
+	return output, nil
+}
+
+// withPollingResourceType tags the context used by the Create, Update and Delete operations of the resource with its
+// Resource Type, so that the polling interval configured for it in `resource_polling_intervals` is used when polling
+// the long-running operations it starts
+func withPollingResourceType(resourceType string, resource *schema.Resource) {
+	wrapContextFunc := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(common.WithPollingResourceType(ctx, resourceType), d, meta)
+		}
+	}
+
+	// untyped resources build their context from the StopContext of the client, so a copy of the client is
+	// passed to them instead. Only the StopContext of the copy is changed - every other field is either a
+	// pointer to a client which is shared (and isn't modified), or the read-only feature flags - so this
+	// doesn't rebuild anything, nor does it modify the client (or the middleware) used by other resources
+	wrapFunc := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if client, ok := meta.(*clients.Client); ok && client.StopContext != nil {
+				tagged := *client
+				tagged.StopContext = common.WithPollingResourceType(client.StopContext, resourceType)
+				meta = &tagged
+			}
+			return f(d, meta)
+		}
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = wrapContextFunc(resource.CreateContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = wrapContextFunc(resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = wrapContextFunc(resource.DeleteContext)
+	}
+
+	//nolint:staticcheck
+	if resource.Create != nil {
+		resource.Create = wrapFunc(resource.Create)
+	}
+	//nolint:staticcheck
+	if resource.Update != nil {
+		resource.Update = wrapFunc(resource.Update)
+	}
+	//nolint:staticcheck
+	if resource.Delete != nil {
+		resource.Delete = wrapFunc(resource.Delete)
+	}
+}
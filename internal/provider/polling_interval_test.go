@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestValidatePollingInterval(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: true,
+		},
+		{
+			Input: "30s",
+			Valid: true,
+		},
+		{
+			Input: "1m30s",
+			Valid: true,
+		},
+		{
+			Input: "1s",
+			Valid: true,
+		},
+		{
+			Input: "500ms",
+			Valid: false,
+		},
+		{
+			Input: "30",
+			Valid: false,
+		},
+		{
+			Input: "-1m",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := validatePollingInterval(v.Input, "polling_interval")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %q to be valid to be %t but got %t", v.Input, v.Valid, valid)
+		}
+	}
+}
+
+func TestExpandResourcePollingIntervals(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_kubernetes_cluster":    {},
+		"azurerm_linux_virtual_machine": {},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]time.Duration
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    map[string]interface{}{},
+			Expected: map[string]time.Duration{},
+		},
+		{
+			Name: "Known Resource Types",
+			Input: map[string]interface{}{
+				"azurerm_kubernetes_cluster":    "1m",
+				"azurerm_linux_virtual_machine": "45s",
+			},
+			Expected: map[string]time.Duration{
+				"azurerm_kubernetes_cluster":    time.Minute,
+				"azurerm_linux_virtual_machine": 45 * time.Second,
+			},
+		},
+		{
+			Name: "Unknown Resource Type",
+			Input: map[string]interface{}{
+				"azurerm_does_not_exist": "1m",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := expandResourcePollingIntervals(v.Input, resources)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithPollingResourceTypeDoesNotModifyClient(t *testing.T) {
+	var stopContext context.Context
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			stopContext = meta.(*clients.Client).StopContext
+			return nil
+		},
+	}
+	withPollingResourceType("azurerm_example", resource)
+
+	client := &clients.Client{
+		StopContext: context.TODO(),
+	}
+	//nolint:staticcheck
+	if err := resource.Create(nil, client); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if client.StopContext != context.TODO() {
+		t.Fatalf("expected the StopContext of the client to be unchanged")
+	}
+	if stopContext == client.StopContext {
+		t.Fatalf("expected the StopContext passed to the resource to be tagged with the Resource Type")
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -138,6 +139,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	for k, v := range resources {
+		withPollingResourceType(k, v)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -213,6 +218,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The password associated with the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate",
 			},
 
+			"client_certificate_key_vault_secret_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_KEY_VAULT_SECRET_ID", ""),
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				Description:  "The ID of the Key Vault Secret containing the Client Certificate associated with the Service Principal, which is retrieved using a Managed Identity. For use when authenticating as a Service Principal using a Client Certificate.",
+			},
+
 			// Client Secret specific fields
 			"client_secret": {
 				Type:        schema.TypeString,
@@ -286,6 +299,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Allow Azure CLI to be used for Authentication.",
 			},
 
+			// Azure Developer CLI specific fields
+			"use_azd_cli": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_AZD_CLI", false),
+				Description: "Allow the Azure Developer CLI (`azd`) to be used for Authentication.",
+			},
+
 			// Azure AKS Workload Identity fields
 			"use_aks_workload_identity": {
 				Type:        schema.TypeBool,
@@ -333,6 +354,24 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
 			},
+
+			"polling_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_POLLING_INTERVAL", ""),
+				ValidateFunc: validatePollingInterval,
+				Description:  "The minimum interval (e.g. `30s`) between polls of a long-running operation, which can be increased to reduce throttling of read requests when managing a large number of resources.",
+			},
+
+			"resource_polling_intervals": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePollingInterval,
+				},
+				Description: "A map of Resource Types (e.g. `azurerm_linux_virtual_machine`) to the minimum interval between polls of the long-running operations of that Resource Type, which takes precedence over `polling_interval`.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -405,6 +444,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			}
 		}
 
+		if secretId := d.Get("client_certificate_key_vault_secret_id").(string); secretId != "" {
+			if len(clientCertificateData) > 0 || d.Get("client_certificate_path").(string) != "" {
+				return nil, diag.Errorf("only one of `client_certificate`, `client_certificate_path` and `client_certificate_key_vault_secret_id` can be specified")
+			}
+
+			logEntry("[DEBUG] Retrieving the Client Certificate from Key Vault Secret %q", secretId)
+			if clientCertificateData, err = getClientCertificateFromKeyVault(ctx, d, *env, secretId); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
 		var (
 			enableAzureCli        = d.Get("use_cli").(bool)
 			enableManagedIdentity = d.Get("use_msi").(bool)
@@ -445,6 +495,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 
+	resourcePollingIntervals, err := expandResourcePollingIntervals(d.Get("resource_polling_intervals").(map[string]interface{}), p.ResourcesMap)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
@@ -452,10 +507,13 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		PollingInterval:             expandPollingInterval(d.Get("polling_interval").(string)),
+		ResourcePollingIntervals:    resourcePollingIntervals,
 		SkipProviderRegistration:    skipProviderRegistration,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
+		UseAzureDeveloperCLI:        d.Get("use_azd_cli").(bool),
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
@@ -474,6 +532,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	}
 
 	client.StopContext = stopCtx
+	client.ResourceTypes = p.ResourcesMap
 
 	if !skipProviderRegistration {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights"
@@ -104,11 +105,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/quota"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redhatopenshift"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter"
@@ -126,6 +129,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/systemcentervirtualmachinemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager"
@@ -141,6 +145,8 @@ import (
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	services := []sdk.TypedServiceRegistration{
 		aadb2c.Registration{},
+		advisor.Registration{},
+		apicenter.Registration{},
 		apimanagement.Registration{},
 		appconfiguration.Registration{},
 		applicationinsights.Registration{},
@@ -152,6 +158,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		automation.Registration{},
 		azurestackhci.Registration{},
 		batch.Registration{},
+		billing.Registration{},
 		bot.Registration{},
 		cognitive.Registration{},
 		communication.Registration{},
@@ -200,9 +207,12 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		paloalto.Registration{},
 		policy.Registration{},
 		privatednsresolver.Registration{},
+		quota.Registration{},
 		recoveryservices.Registration{},
 		redis.Registration{},
 		redhatopenshift.Registration{},
+		relay.Registration{},
+		reservations.Registration{},
 		resource.Registration{},
 		sentinel.Registration{},
 		serviceconnector.Registration{},
@@ -212,6 +222,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		storagemover.Registration{},
 		signalr.Registration{},
 		subscription.Registration{},
+		support.Registration{},
 		orbital.Registration{},
 		streamanalytics.Registration{},
 		search.Registration{},
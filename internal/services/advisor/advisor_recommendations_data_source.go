@@ -42,6 +42,15 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 				},
 			},
 
+			"filter_by_impact": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(getrecommendations.PossibleValuesForImpact(), false),
+				},
+			},
+
 			"filter_by_resource_groups": commonschema.ResourceGroupNameSetOptional(),
 
 			"recommendations": {
@@ -64,6 +73,11 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"recommendation_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"recommendation_name": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -74,6 +88,11 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"resource_name": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -128,7 +147,24 @@ func dataSourceAdvisorRecommendationsRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("loading Advisor Recommendation for %q: %+v", id, err)
 	}
 
-	if err := d.Set("recommendations", flattenAzureRmAdvisorRecommendations(recomendations.Items)); err != nil {
+	// the API doesn't support filtering on the impact, so this is done once the recommendations have been retrieved
+	items := recomendations.Items
+	if impacts := d.Get("filter_by_impact").(*pluginsdk.Set).List(); len(impacts) > 0 {
+		items = make([]getrecommendations.ResourceRecommendationBase, 0)
+		for _, item := range recomendations.Items {
+			if item.Properties == nil {
+				continue
+			}
+			for _, impact := range impacts {
+				if strings.EqualFold(string(pointer.From(item.Properties.Impact)), impact.(string)) {
+					items = append(items, item)
+					break
+				}
+			}
+		}
+	}
+
+	if err := d.Set("recommendations", flattenAzureRmAdvisorRecommendations(items)); err != nil {
 		return fmt.Errorf("setting `recommendations`: %+v", err)
 	}
 
@@ -146,14 +182,22 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 
 	for _, r := range recommends {
 		var description string
+		var resourceId string
 		var suppressionIds []interface{}
 
 		v := r.Properties
+		if v == nil {
+			continue
+		}
 
 		if v.ShortDescription != nil && v.ShortDescription.Problem != nil {
 			description = *v.ShortDescription.Problem
 		}
 
+		if v.ResourceMetadata != nil {
+			resourceId = pointer.From(v.ResourceMetadata.ResourceId)
+		}
+
 		if v.SuppressionIds != nil {
 			suppressionIds = flattenSuppressionSlice(v.SuppressionIds)
 		}
@@ -162,8 +206,10 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 			"category":               string(pointer.From(v.Category)),
 			"description":            description,
 			"impact":                 string(pointer.From(v.Impact)),
+			"recommendation_id":      pointer.From(r.Id),
 			"recommendation_name":    pointer.From(r.Name),
 			"recommendation_type_id": pointer.From(v.RecommendationTypeId),
+			"resource_id":            resourceId,
 			"resource_name":          pointer.From(v.ImpactedValue),
 			"resource_type":          pointer.From(v.ImpactedField),
 			"suppression_names":      suppressionIds,
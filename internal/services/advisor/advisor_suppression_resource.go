@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/getrecommendations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/suppressions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AdvisorSuppressionResource struct{}
+
+type AdvisorSuppressionModel struct {
+	Name             string `tfschema:"name"`
+	RecommendationId string `tfschema:"recommendation_id"`
+	Ttl              string `tfschema:"ttl"`
+
+	ExpirationTime string `tfschema:"expiration_time"`
+	SuppressionId  string `tfschema:"suppression_id"`
+}
+
+var _ sdk.ResourceWithUpdate = AdvisorSuppressionResource{}
+
+func (r AdvisorSuppressionResource) ModelObject() interface{} {
+	return &AdvisorSuppressionModel{}
+}
+
+func (r AdvisorSuppressionResource) ResourceType() string {
+	return "azurerm_advisor_suppression"
+}
+
+func (r AdvisorSuppressionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return suppressions.ValidateScopedSuppressionID
+}
+
+func (r AdvisorSuppressionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"recommendation_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: getrecommendations.ValidateScopedRecommendationID,
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(-1|(\d{1,2}\.)?\d{2}:\d{2}:\d{2})$`),
+				"`ttl` must be in the format `dd.hh:mm:ss`, or `-1` to suppress the recommendation indefinitely",
+			),
+		},
+	}
+}
+
+func (r AdvisorSuppressionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"suppression_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r AdvisorSuppressionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			var config AdvisorSuppressionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			recommendationId, err := getrecommendations.ParseScopedRecommendationID(config.RecommendationId)
+			if err != nil {
+				return err
+			}
+
+			id := suppressions.NewScopedSuppressionID(recommendationId.ResourceUri, recommendationId.RecommendationId, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := suppressions.SuppressionContract{
+				Properties: &suppressions.SuppressionProperties{},
+			}
+			if config.Ttl != "" {
+				payload.Properties.Ttl = pointer.To(config.Ttl)
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AdvisorSuppressionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AdvisorSuppressionModel{
+				Name:             id.SuppressionName,
+				RecommendationId: getrecommendations.NewScopedRecommendationID(id.ResourceUri, id.RecommendationId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Ttl = pointer.From(props.Ttl)
+					state.ExpirationTime = pointer.From(props.ExpirationTimeStamp)
+					state.SuppressionId = pointer.From(props.SuppressionId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AdvisorSuppressionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config AdvisorSuppressionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := suppressions.SuppressionContract{
+				Properties: &suppressions.SuppressionProperties{
+					Ttl: pointer.To(config.Ttl),
+				},
+			}
+
+			if _, err := client.Create(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AdvisorSuppressionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
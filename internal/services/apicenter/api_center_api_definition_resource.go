@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiCenterApiDefinitionResource struct{}
+
+type ApiCenterApiDefinitionModel struct {
+	Name                  string                                `tfschema:"name"`
+	ApiCenterApiVersionId string                                `tfschema:"api_center_api_version_id"`
+	Title                 string                                `tfschema:"title"`
+	Description           string                                `tfschema:"description"`
+	Specification         []ApiCenterApiDefinitionSpecification `tfschema:"specification"`
+}
+
+type ApiCenterApiDefinitionSpecification struct {
+	Name    string `tfschema:"name"`
+	Format  string `tfschema:"format"`
+	Value   string `tfschema:"value"`
+	Version string `tfschema:"version"`
+}
+
+var _ sdk.ResourceWithUpdate = ApiCenterApiDefinitionResource{}
+
+func (r ApiCenterApiDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName(),
+		},
+
+		"api_center_api_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apidefinitions.ValidateVersionID,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"specification": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"format": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(apidefinitions.PossibleValuesForApiSpecImportSourceFormat(), false),
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterApiDefinitionResource) ModelObject() interface{} {
+	return &ApiCenterApiDefinitionModel{}
+}
+
+func (r ApiCenterApiDefinitionResource) ResourceType() string {
+	return "azurerm_api_center_api_definition"
+}
+
+func (r ApiCenterApiDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apidefinitions.ValidateDefinitionID
+}
+
+func (r ApiCenterApiDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			var config ApiCenterApiDefinitionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			versionId, err := apidefinitions.ParseVersionID(config.ApiCenterApiVersionId)
+			if err != nil {
+				return err
+			}
+
+			id := apidefinitions.NewDefinitionID(versionId.SubscriptionId, versionId.ResourceGroupName, versionId.ServiceName, versionId.WorkspaceName, versionId.ApiName, versionId.VersionName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := apidefinitions.ApiDefinition{
+				Properties: &apidefinitions.ApiDefinitionProperties{
+					Title: config.Title,
+				},
+			}
+			if config.Description != "" {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(config.Specification) > 0 {
+				if err := client.ImportSpecificationThenPoll(ctx, id, expandApiCenterApiDefinitionSpecification(config.Specification)); err != nil {
+					return fmt.Errorf("importing the specification for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config ApiCenterApiDefinitionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiCenterApiDefinitionModel{
+				Name:                  id.DefinitionName,
+				ApiCenterApiVersionId: apidefinitions.NewVersionID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName, id.VersionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Description = pointer.From(props.Description)
+
+					// the imported document isn't returned by the API, so the `specification` block is retained from the
+					// config - and when imported, populated from the `name` and `version` detected during the import
+					state.Specification = config.Specification
+					if spec := props.Specification; len(state.Specification) == 0 && spec != nil && spec.Name != nil {
+						state.Specification = []ApiCenterApiDefinitionSpecification{
+							{
+								Name:    pointer.From(spec.Name),
+								Version: pointer.From(spec.Version),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterApiDefinitionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("title", "description") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+				if existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+				}
+
+				payload := *existing.Model
+				payload.Properties.Title = config.Title
+				payload.Properties.Description = pointer.To(config.Description)
+
+				if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("specification") && len(config.Specification) > 0 {
+				if err := client.ImportSpecificationThenPoll(ctx, *id, expandApiCenterApiDefinitionSpecification(config.Specification)); err != nil {
+					return fmt.Errorf("importing the specification for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterApiDefinitionSpecification(input []ApiCenterApiDefinitionSpecification) apidefinitions.ApiSpecImportRequest {
+	spec := input[0]
+
+	output := apidefinitions.ApiSpecImportRequest{
+		Format: pointer.To(apidefinitions.ApiSpecImportSourceFormat(spec.Format)),
+		Specification: &apidefinitions.ApiSpecImportRequestSpecification{
+			Name: pointer.To(spec.Name),
+		},
+		Value: pointer.To(spec.Value),
+	}
+	if spec.Version != "" {
+		output.Specification.Version = pointer.To(spec.Version)
+	}
+
+	return output
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterApiDefinitionResource struct{}
+
+func (r ApiCenterApiDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apidefinitions.ParseDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.ApiDefinitions.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccApiCenterApiDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApiDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterApiDefinition_specification(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.specification(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("specification.0.format", "specification.0.value"),
+	})
+}
+
+func (r ApiCenterApiDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctest-def-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+}
+`, ApiCenterApiVersionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "import" {
+  name                      = azurerm_api_center_api_definition.test.name
+  api_center_api_version_id = azurerm_api_center_api_definition.test.api_center_api_version_id
+  title                     = azurerm_api_center_api_definition.test.title
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterApiDefinitionResource) specification(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctest-def-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+  description               = "The OpenAPI definition"
+
+  specification {
+    name    = "openapi"
+    version = "3.0.1"
+    format  = "inline"
+    value = jsonencode({
+      openapi = "3.0.1"
+      info = {
+        title   = "Example"
+        version = "1.0"
+      }
+      paths = {}
+    })
+  }
+}
+`, ApiCenterApiVersionResource{}.basic(data), data.RandomInteger)
+}
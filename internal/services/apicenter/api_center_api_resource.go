@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiCenterApiResource struct{}
+
+type ApiCenterApiModel struct {
+	Name                  string                           `tfschema:"name"`
+	ApiCenterWorkspaceId  string                           `tfschema:"api_center_workspace_id"`
+	Title                 string                           `tfschema:"title"`
+	Kind                  string                           `tfschema:"kind"`
+	Contact               []ApiCenterApiContact            `tfschema:"contact"`
+	Description           string                           `tfschema:"description"`
+	ExternalDocumentation []ApiCenterExternalDocumentation `tfschema:"external_documentation"`
+	License               []ApiCenterApiLicense            `tfschema:"license"`
+	LifecycleStage        string                           `tfschema:"lifecycle_stage"`
+	Summary               string                           `tfschema:"summary"`
+	TermsOfServiceUrl     string                           `tfschema:"terms_of_service_url"`
+}
+
+type ApiCenterApiContact struct {
+	Email string `tfschema:"email"`
+	Name  string `tfschema:"name"`
+	Url   string `tfschema:"url"`
+}
+
+type ApiCenterExternalDocumentation struct {
+	Url         string `tfschema:"url"`
+	Description string `tfschema:"description"`
+	Title       string `tfschema:"title"`
+}
+
+type ApiCenterApiLicense struct {
+	Identifier string `tfschema:"identifier"`
+	Name       string `tfschema:"name"`
+	Url        string `tfschema:"url"`
+}
+
+var _ sdk.ResourceWithUpdate = ApiCenterApiResource{}
+
+func (r ApiCenterApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName(),
+		},
+
+		"api_center_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apis.ValidateWorkspaceID,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(apis.PossibleValuesForApiKind(), false),
+		},
+
+		"contact": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"external_documentation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"title": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"license": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identifier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"lifecycle_stage": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(apis.PossibleValuesForLifecycleStage(), false),
+		},
+
+		"summary": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"terms_of_service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterApiResource) ModelObject() interface{} {
+	return &ApiCenterApiModel{}
+}
+
+func (r ApiCenterApiResource) ResourceType() string {
+	return "azurerm_api_center_api"
+}
+
+func (r ApiCenterApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apis.ValidateApiID
+}
+
+func (r ApiCenterApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			var config ApiCenterApiModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := apis.ParseWorkspaceID(config.ApiCenterWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := apis.NewApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := apis.Api{
+				Properties: expandApiCenterApiProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterApiModel{
+				Name:                 id.ApiName,
+				ApiCenterWorkspaceId: apis.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Kind = string(props.Kind)
+					state.Contact = flattenApiCenterApiContacts(props.Contacts)
+					state.Description = pointer.From(props.Description)
+					state.ExternalDocumentation = flattenApiCenterExternalDocumentation(props.ExternalDocumentation)
+					state.License = flattenApiCenterApiLicense(props.License)
+					state.LifecycleStage = string(pointer.From(props.LifecycleStage))
+					state.Summary = pointer.From(props.Summary)
+					if props.TermsOfService != nil {
+						state.TermsOfServiceUrl = props.TermsOfService.Url
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterApiModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the custom properties aren't managed by this resource, so these are retained
+			payload := *existing.Model
+			properties := expandApiCenterApiProperties(config)
+			if payload.Properties != nil {
+				properties.CustomProperties = payload.Properties.CustomProperties
+			}
+			payload.Properties = properties
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterApiProperties(input ApiCenterApiModel) *apis.ApiProperties {
+	output := &apis.ApiProperties{
+		Kind:  apis.ApiKind(input.Kind),
+		Title: input.Title,
+	}
+
+	contacts := make([]apis.Contact, 0)
+	for _, v := range input.Contact {
+		contact := apis.Contact{}
+		if v.Email != "" {
+			contact.Email = pointer.To(v.Email)
+		}
+		if v.Name != "" {
+			contact.Name = pointer.To(v.Name)
+		}
+		if v.Url != "" {
+			contact.Url = pointer.To(v.Url)
+		}
+		contacts = append(contacts, contact)
+	}
+	output.Contacts = &contacts
+
+	externalDocumentation := make([]apis.ExternalDocumentation, 0)
+	for _, v := range input.ExternalDocumentation {
+		item := apis.ExternalDocumentation{
+			Url: v.Url,
+		}
+		if v.Description != "" {
+			item.Description = pointer.To(v.Description)
+		}
+		if v.Title != "" {
+			item.Title = pointer.To(v.Title)
+		}
+		externalDocumentation = append(externalDocumentation, item)
+	}
+	output.ExternalDocumentation = &externalDocumentation
+
+	if len(input.License) > 0 {
+		license := apis.License{}
+		if v := input.License[0].Identifier; v != "" {
+			license.Identifier = pointer.To(v)
+		}
+		if v := input.License[0].Name; v != "" {
+			license.Name = pointer.To(v)
+		}
+		if v := input.License[0].Url; v != "" {
+			license.Url = pointer.To(v)
+		}
+		output.License = &license
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.LifecycleStage != "" {
+		output.LifecycleStage = pointer.To(apis.LifecycleStage(input.LifecycleStage))
+	}
+
+	if input.Summary != "" {
+		output.Summary = pointer.To(input.Summary)
+	}
+
+	if input.TermsOfServiceUrl != "" {
+		output.TermsOfService = &apis.TermsOfService{
+			Url: input.TermsOfServiceUrl,
+		}
+	}
+
+	return output
+}
+
+func flattenApiCenterApiContacts(input *[]apis.Contact) []ApiCenterApiContact {
+	output := make([]ApiCenterApiContact, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ApiCenterApiContact{
+			Email: pointer.From(v.Email),
+			Name:  pointer.From(v.Name),
+			Url:   pointer.From(v.Url),
+		})
+	}
+
+	return output
+}
+
+func flattenApiCenterExternalDocumentation(input *[]apis.ExternalDocumentation) []ApiCenterExternalDocumentation {
+	output := make([]ApiCenterExternalDocumentation, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ApiCenterExternalDocumentation{
+			Url:         v.Url,
+			Description: pointer.From(v.Description),
+			Title:       pointer.From(v.Title),
+		})
+	}
+
+	return output
+}
+
+func flattenApiCenterApiLicense(input *apis.License) []ApiCenterApiLicense {
+	output := make([]ApiCenterApiLicense, 0)
+	if input == nil {
+		return output
+	}
+
+	return append(output, ApiCenterApiLicense{
+		Identifier: pointer.From(input.Identifier),
+		Name:       pointer.From(input.Name),
+		Url:        pointer.From(input.Url),
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterApiResource struct{}
+
+func (r ApiCenterApiResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apis.ParseApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.Apis.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccApiCenterApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterApiResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "test" {
+  name                    = "acctest-api-%d"
+  api_center_workspace_id = azurerm_api_center_workspace.test.id
+  title                   = "Example API"
+  kind                    = "rest"
+}
+`, ApiCenterWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "import" {
+  name                    = azurerm_api_center_api.test.name
+  api_center_workspace_id = azurerm_api_center_api.test.api_center_workspace_id
+  title                   = azurerm_api_center_api.test.title
+  kind                    = azurerm_api_center_api.test.kind
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterApiResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "test" {
+  name                    = "acctest-api-%d"
+  api_center_workspace_id = azurerm_api_center_workspace.test.id
+  title                   = "Updated API"
+  kind                    = "rest"
+  description             = "An example API"
+  summary                 = "Example"
+  lifecycle_stage         = "development"
+  terms_of_service_url    = "https://example.com/terms"
+
+  contact {
+    name  = "Example"
+    email = "example@example.com"
+    url   = "https://example.com"
+  }
+
+  external_documentation {
+    title = "Docs"
+    url   = "https://example.com/docs"
+  }
+
+  license {
+    name       = "MIT"
+    identifier = "MIT"
+  }
+}
+`, ApiCenterWorkspaceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiCenterDeploymentResource struct{}
+
+type ApiCenterDeploymentModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiCenterApiId           string   `tfschema:"api_center_api_id"`
+	Title                    string   `tfschema:"title"`
+	ApiCenterEnvironmentId   string   `tfschema:"api_center_environment_id"`
+	ApiCenterApiDefinitionId string   `tfschema:"api_center_api_definition_id"`
+	Description              string   `tfschema:"description"`
+	RuntimeUris              []string `tfschema:"runtime_uris"`
+	State                    string   `tfschema:"state"`
+}
+
+var _ sdk.ResourceWithUpdate = ApiCenterDeploymentResource{}
+
+func (r ApiCenterDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName(),
+		},
+
+		"api_center_api_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: deployments.ValidateApiID,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"api_center_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: environments.ValidateEnvironmentID,
+		},
+
+		"api_center_api_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: apidefinitions.ValidateDefinitionID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"runtime_uris": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deployments.DeploymentStateActive),
+			ValidateFunc: validation.StringInSlice(deployments.PossibleValuesForDeploymentState(), false),
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterDeploymentResource) ModelObject() interface{} {
+	return &ApiCenterDeploymentModel{}
+}
+
+func (r ApiCenterDeploymentResource) ResourceType() string {
+	return "azurerm_api_center_deployment"
+}
+
+func (r ApiCenterDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deployments.ValidateDeploymentID
+}
+
+func (r ApiCenterDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			var config ApiCenterDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := deployments.ParseApiID(config.ApiCenterApiId)
+			if err != nil {
+				return err
+			}
+
+			id := deployments.NewDeploymentID(apiId.SubscriptionId, apiId.ResourceGroupName, apiId.ServiceName, apiId.WorkspaceName, apiId.ApiName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandApiCenterDeploymentProperties(id, config)
+			if err != nil {
+				return err
+			}
+
+			payload := deployments.Deployment{
+				Properties: properties,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterDeploymentModel{
+				Name:           id.DeploymentName,
+				ApiCenterApiId: deployments.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = pointer.From(props.Title)
+					state.Description = pointer.From(props.Description)
+					state.State = string(pointer.From(props.State))
+
+					serviceId := services.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+					if v := pointer.From(props.EnvironmentId); v != "" {
+						environmentId, err := environments.ParseEnvironmentIDInsensitively(serviceId.ID() + v)
+						if err != nil {
+							return err
+						}
+						state.ApiCenterEnvironmentId = environmentId.ID()
+					}
+
+					if v := pointer.From(props.DefinitionId); v != "" {
+						definitionId, err := apidefinitions.ParseDefinitionIDInsensitively(serviceId.ID() + v)
+						if err != nil {
+							return err
+						}
+						state.ApiCenterApiDefinitionId = definitionId.ID()
+					}
+
+					if server := props.Server; server != nil {
+						state.RuntimeUris = pointer.From(server.RuntimeUri)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			properties, err := expandApiCenterDeploymentProperties(*id, config)
+			if err != nil {
+				return err
+			}
+
+			// the custom properties aren't managed by this resource, so these are retained
+			payload := *existing.Model
+			if payload.Properties != nil {
+				properties.CustomProperties = payload.Properties.CustomProperties
+			}
+			payload.Properties = properties
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandApiCenterDeploymentProperties builds the properties for the Deployment, where the Environment and API Definition
+// are referenced by their IDs relative to the API Center Service (e.g. `/workspaces/default/environments/example`)
+func expandApiCenterDeploymentProperties(id deployments.DeploymentId, input ApiCenterDeploymentModel) (*deployments.DeploymentProperties, error) {
+	serviceId := services.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+	environmentId, err := environments.ParseEnvironmentID(input.ApiCenterEnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	environmentWorkspaceId := environments.NewWorkspaceID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ServiceName, environmentId.WorkspaceName)
+	workspaceId := environments.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName)
+	if !strings.EqualFold(environmentWorkspaceId.ID(), workspaceId.ID()) {
+		return nil, fmt.Errorf("`api_center_environment_id` must be within the same API Center Workspace as the %s", id)
+	}
+
+	definitionId, err := apidefinitions.ParseDefinitionID(input.ApiCenterApiDefinitionId)
+	if err != nil {
+		return nil, err
+	}
+	definitionApiId := deployments.NewApiID(definitionId.SubscriptionId, definitionId.ResourceGroupName, definitionId.ServiceName, definitionId.WorkspaceName, definitionId.ApiName)
+	apiId := deployments.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName)
+	if !strings.EqualFold(definitionApiId.ID(), apiId.ID()) {
+		return nil, fmt.Errorf("`api_center_api_definition_id` must be within the same API Center API as the %s", id)
+	}
+
+	output := &deployments.DeploymentProperties{
+		DefinitionId:  pointer.To(strings.TrimPrefix(definitionId.ID(), serviceId.ID())),
+		EnvironmentId: pointer.To(strings.TrimPrefix(environmentId.ID(), serviceId.ID())),
+		Server: &deployments.DeploymentServer{
+			RuntimeUri: pointer.To(input.RuntimeUris),
+		},
+		State: pointer.To(deployments.DeploymentState(input.State)),
+		Title: pointer.To(input.Title),
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	return output, nil
+}
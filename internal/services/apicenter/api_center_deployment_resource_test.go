@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterDeploymentResource struct{}
+
+func (r ApiCenterDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deployments.ParseDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.Deployments.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccApiCenterDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("inactive"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_environment" "test" {
+  name                    = "acctest-env-%d"
+  api_center_workspace_id = azurerm_api_center_workspace.test.id
+  title                   = "Production"
+  kind                    = "production"
+}
+`, ApiCenterApiDefinitionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiCenterDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "test" {
+  name                         = "acctest-dep-%d"
+  api_center_api_id            = azurerm_api_center_api.test.id
+  title                        = "Production Deployment"
+  api_center_environment_id    = azurerm_api_center_environment.test.id
+  api_center_api_definition_id = azurerm_api_center_api_definition.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "import" {
+  name                         = azurerm_api_center_deployment.test.name
+  api_center_api_id            = azurerm_api_center_deployment.test.api_center_api_id
+  title                        = azurerm_api_center_deployment.test.title
+  api_center_environment_id    = azurerm_api_center_deployment.test.api_center_environment_id
+  api_center_api_definition_id = azurerm_api_center_deployment.test.api_center_api_definition_id
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterDeploymentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "test" {
+  name                         = "acctest-dep-%d"
+  api_center_api_id            = azurerm_api_center_api.test.id
+  title                        = "Production Deployment (Retired)"
+  api_center_environment_id    = azurerm_api_center_environment.test.id
+  api_center_api_definition_id = azurerm_api_center_api_definition.test.id
+  description                  = "The production deployment"
+  runtime_uris                 = ["https://api.example.com"]
+  state                        = "inactive"
+}
+`, r.template(data), data.RandomInteger)
+}
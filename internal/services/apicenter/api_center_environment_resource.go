@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiCenterEnvironmentResource struct{}
+
+type ApiCenterEnvironmentModel struct {
+	Name                 string                           `tfschema:"name"`
+	ApiCenterWorkspaceId string                           `tfschema:"api_center_workspace_id"`
+	Title                string                           `tfschema:"title"`
+	Kind                 string                           `tfschema:"kind"`
+	Description          string                           `tfschema:"description"`
+	Onboarding           []ApiCenterEnvironmentOnboarding `tfschema:"onboarding"`
+	Server               []ApiCenterEnvironmentServer     `tfschema:"server"`
+}
+
+type ApiCenterEnvironmentOnboarding struct {
+	DeveloperPortalUris []string `tfschema:"developer_portal_uris"`
+	Instructions        string   `tfschema:"instructions"`
+}
+
+type ApiCenterEnvironmentServer struct {
+	Type                 string   `tfschema:"type"`
+	ManagementPortalUris []string `tfschema:"management_portal_uris"`
+}
+
+var _ sdk.ResourceWithUpdate = ApiCenterEnvironmentResource{}
+
+func (r ApiCenterEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName(),
+		},
+
+		"api_center_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: environments.ValidateWorkspaceID,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(environments.PossibleValuesForEnvironmentKind(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"onboarding": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"developer_portal_uris": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						AtLeastOneOf: []string{"onboarding.0.developer_portal_uris", "onboarding.0.instructions"},
+					},
+
+					"instructions": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						AtLeastOneOf: []string{"onboarding.0.developer_portal_uris", "onboarding.0.instructions"},
+					},
+				},
+			},
+		},
+
+		"server": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(environments.PossibleValuesForEnvironmentServerType(), false),
+					},
+
+					"management_portal_uris": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterEnvironmentResource) ModelObject() interface{} {
+	return &ApiCenterEnvironmentModel{}
+}
+
+func (r ApiCenterEnvironmentResource) ResourceType() string {
+	return "azurerm_api_center_environment"
+}
+
+func (r ApiCenterEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return environments.ValidateEnvironmentID
+}
+
+func (r ApiCenterEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			var config ApiCenterEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := environments.ParseWorkspaceID(config.ApiCenterWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := environments.NewEnvironmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := environments.Environment{
+				Properties: expandApiCenterEnvironmentProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterEnvironmentModel{
+				Name:                 id.EnvironmentName,
+				ApiCenterWorkspaceId: environments.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Kind = string(props.Kind)
+					state.Description = pointer.From(props.Description)
+					state.Onboarding = flattenApiCenterEnvironmentOnboarding(props.Onboarding)
+					state.Server = flattenApiCenterEnvironmentServer(props.Server)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the custom properties aren't managed by this resource, so these are retained
+			payload := *existing.Model
+			properties := expandApiCenterEnvironmentProperties(config)
+			if payload.Properties != nil {
+				properties.CustomProperties = payload.Properties.CustomProperties
+			}
+			payload.Properties = properties
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterEnvironmentProperties(input ApiCenterEnvironmentModel) *environments.EnvironmentProperties {
+	output := &environments.EnvironmentProperties{
+		Kind:  environments.EnvironmentKind(input.Kind),
+		Title: input.Title,
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if len(input.Onboarding) > 0 {
+		onboarding := environments.Onboarding{
+			DeveloperPortalUri: pointer.To(input.Onboarding[0].DeveloperPortalUris),
+		}
+		if v := input.Onboarding[0].Instructions; v != "" {
+			onboarding.Instructions = pointer.To(v)
+		}
+		output.Onboarding = &onboarding
+	}
+
+	if len(input.Server) > 0 {
+		output.Server = &environments.EnvironmentServer{
+			ManagementPortalUri: pointer.To(input.Server[0].ManagementPortalUris),
+			Type:                pointer.To(environments.EnvironmentServerType(input.Server[0].Type)),
+		}
+	}
+
+	return output
+}
+
+func flattenApiCenterEnvironmentOnboarding(input *environments.Onboarding) []ApiCenterEnvironmentOnboarding {
+	output := make([]ApiCenterEnvironmentOnboarding, 0)
+	if input == nil || (input.Instructions == nil && len(pointer.From(input.DeveloperPortalUri)) == 0) {
+		return output
+	}
+
+	return append(output, ApiCenterEnvironmentOnboarding{
+		DeveloperPortalUris: pointer.From(input.DeveloperPortalUri),
+		Instructions:        pointer.From(input.Instructions),
+	})
+}
+
+func flattenApiCenterEnvironmentServer(input *environments.EnvironmentServer) []ApiCenterEnvironmentServer {
+	output := make([]ApiCenterEnvironmentServer, 0)
+	if input == nil || input.Type == nil {
+		return output
+	}
+
+	return append(output, ApiCenterEnvironmentServer{
+		Type:                 string(pointer.From(input.Type)),
+		ManagementPortalUris: pointer.From(input.ManagementPortalUri),
+	})
+}
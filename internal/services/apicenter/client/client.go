@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	apicenter_2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func NewClient(o *common.ClientOptions) (*apicenter_2024_03_01.Client, error) {
+	client, err := apicenter_2024_03_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building ApiCenter client: %+v", err)
+	}
+	return client, nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/api-center"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ApiCenterServiceResource{},
+		ApiCenterWorkspaceResource{},
+		ApiCenterApiResource{},
+		ApiCenterApiVersionResource{},
+		ApiCenterApiDefinitionResource{},
+		ApiCenterEnvironmentResource{},
+		ApiCenterDeploymentResource{},
+	}
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "API Center"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"API Center",
+	}
+}
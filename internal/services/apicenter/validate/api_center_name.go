@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ApiCenterName validates the name of an API Center Service, and of the Workspaces, APIs, API Versions, API Definitions,
+// Environments and Deployments within it - which all share the same naming rules.
+func ApiCenterName() pluginsdk.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(3, 90),
+		validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "the name may contain only letters, numbers and hyphens."),
+		validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]`), "the name must begin with a letter or number."),
+		validation.StringMatch(regexp.MustCompile(`[a-zA-Z0-9]$`), "the name must end with a letter or number."),
+	)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementBackupRestoreModel struct {
+	ApiManagementId    string            `tfschema:"api_management_id"`
+	StorageAccountName string            `tfschema:"storage_account_name"`
+	ContainerName      string            `tfschema:"container_name"`
+	BackupName         string            `tfschema:"backup_name"`
+	AccessType         string            `tfschema:"access_type"`
+	AccessKey          string            `tfschema:"access_key"`
+	ClientId           string            `tfschema:"client_id"`
+	Triggers           map[string]string `tfschema:"triggers"`
+}
+
+type ApiManagementBackupResource struct{}
+
+var _ sdk.Resource = ApiManagementBackupResource{}
+
+func (r ApiManagementBackupResource) ModelObject() interface{} {
+	return &ApiManagementBackupRestoreModel{}
+}
+
+func (r ApiManagementBackupResource) ResourceType() string {
+	return "azurerm_api_management_backup"
+}
+
+func (r ApiManagementBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apimanagementservice.ValidateServiceID
+}
+
+func (r ApiManagementBackupResource) Arguments() map[string]*pluginsdk.Schema {
+	return apiManagementBackupRestoreArguments()
+}
+
+func (r ApiManagementBackupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementBackupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ServiceClient
+
+			var model ApiManagementBackupRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := apimanagementservice.ParseServiceID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			parameters, err := expandApiManagementBackupRestoreParameters(model)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			metadata.Logger.Infof("backing up %s to %q", *id, model.BackupName)
+			if err := client.BackupThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("backing up %s to the Backup %q in the Container %q within the Storage Account %q: %+v", *id, model.BackupName, model.ContainerName, model.StorageAccountName, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementBackupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return readApiManagementBackupRestore(ctx, metadata)
+		},
+	}
+}
+
+func (r ApiManagementBackupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: the backup is retained in the Storage Account, so it can still be restored once this resource is removed
+			return nil
+		},
+	}
+}
+
+func apiManagementBackupRestoreArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apimanagementservice.ValidateServiceID,
+		},
+
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageAccountName,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageContainerName,
+		},
+
+		"backup_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(apimanagementservice.AccessTypeSystemAssignedManagedIdentity),
+			ValidateFunc: validation.StringInSlice(apimanagementservice.PossibleValuesForAccessType(), false),
+		},
+
+		"access_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"client_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func expandApiManagementBackupRestoreParameters(input ApiManagementBackupRestoreModel) (*apimanagementservice.ApiManagementServiceBackupRestoreParameters, error) {
+	accessType := apimanagementservice.AccessType(input.AccessType)
+
+	output := apimanagementservice.ApiManagementServiceBackupRestoreParameters{
+		AccessType:     pointer.To(accessType),
+		BackupName:     input.BackupName,
+		ContainerName:  input.ContainerName,
+		StorageAccount: input.StorageAccountName,
+	}
+
+	switch accessType {
+	case apimanagementservice.AccessTypeAccessKey:
+		if input.AccessKey == "" {
+			return nil, fmt.Errorf("`access_key` must be specified when `access_type` is `%s`", accessType)
+		}
+		output.AccessKey = pointer.To(input.AccessKey)
+	case apimanagementservice.AccessTypeUserAssignedManagedIdentity:
+		if input.ClientId == "" {
+			return nil, fmt.Errorf("`client_id` must be specified when `access_type` is `%s`", accessType)
+		}
+		output.ClientId = pointer.To(input.ClientId)
+	}
+
+	if accessType != apimanagementservice.AccessTypeAccessKey && input.AccessKey != "" {
+		return nil, fmt.Errorf("`access_key` can only be specified when `access_type` is `%s`", apimanagementservice.AccessTypeAccessKey)
+	}
+	if accessType != apimanagementservice.AccessTypeUserAssignedManagedIdentity && input.ClientId != "" {
+		return nil, fmt.Errorf("`client_id` can only be specified when `access_type` is `%s`", apimanagementservice.AccessTypeUserAssignedManagedIdentity)
+	}
+
+	return &output, nil
+}
+
+func readApiManagementBackupRestore(ctx context.Context, metadata sdk.ResourceMetaData) error {
+	client := metadata.Client.ApiManagement.ServiceClient
+
+	id, err := apimanagementservice.ParseServiceID(metadata.ResourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return metadata.MarkAsGone(id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the backup/restore operation can't be retrieved once completed, so the existing state is retained
+	var state ApiManagementBackupRestoreModel
+	if err := metadata.Decode(&state); err != nil {
+		return fmt.Errorf("decoding: %+v", err)
+	}
+
+	state.ApiManagementId = id.ID()
+
+	return metadata.Encode(&state)
+}
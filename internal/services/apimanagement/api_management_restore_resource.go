@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementRestoreResource struct{}
+
+var _ sdk.Resource = ApiManagementRestoreResource{}
+
+func (r ApiManagementRestoreResource) ModelObject() interface{} {
+	return &ApiManagementBackupRestoreModel{}
+}
+
+func (r ApiManagementRestoreResource) ResourceType() string {
+	return "azurerm_api_management_restore"
+}
+
+func (r ApiManagementRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apimanagementservice.ValidateServiceID
+}
+
+func (r ApiManagementRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return apiManagementBackupRestoreArguments()
+}
+
+func (r ApiManagementRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ServiceClient
+
+			var model ApiManagementBackupRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := apimanagementservice.ParseServiceID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			parameters, err := expandApiManagementBackupRestoreParameters(model)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			metadata.Logger.Infof("restoring %s from %q", *id, model.BackupName)
+			if err := client.RestoreThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("restoring %s from the Backup %q in the Container %q within the Storage Account %q: %+v", *id, model.BackupName, model.ContainerName, model.StorageAccountName, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return readApiManagementBackupRestore(ctx, metadata)
+		},
+	}
+}
+
+func (r ApiManagementRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: a restore can't be reverted, so removing this resource doesn't change the API Management Service
+			return nil
+		},
+	}
+}
@@ -5,9 +5,13 @@ package applicationinsights
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -28,6 +32,8 @@ type ApplicationInsightsWorkbookModel struct {
 	DisplayName        string            `tfschema:"display_name"`
 	Location           string            `tfschema:"location"`
 	DataJson           string            `tfschema:"data_json"`
+	DataParameters     map[string]string `tfschema:"data_parameters"`
+	Revision           string            `tfschema:"revision"`
 	SourceId           string            `tfschema:"source_id"`
 	StorageContainerId string            `tfschema:"storage_container_id"`
 	Tags               map[string]string `tfschema:"tags"`
@@ -35,7 +41,10 @@ type ApplicationInsightsWorkbookModel struct {
 
 type ApplicationInsightsWorkbookResource struct{}
 
-var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationInsightsWorkbookResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationInsightsWorkbookResource{}
+)
 
 func (r ApplicationInsightsWorkbookResource) ResourceType() string {
 	return "azurerm_application_insights_workbook"
@@ -78,6 +87,14 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
+		"data_parameters": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
 		"source_id": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -122,7 +139,43 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 }
 
 func (r ApplicationInsightsWorkbookResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"revision": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationInsightsWorkbookResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if rd.Id() != "" && rd.HasChanges("data_json", "data_parameters") {
+				if err := rd.SetNewComputed("revision"); err != nil {
+					return err
+				}
+			}
+
+			// the template can only be rendered once both the template and its parameters are known
+			if !rd.NewValueKnown("data_json") || !rd.NewValueKnown("data_parameters") {
+				return nil
+			}
+
+			parameters := make(map[string]string)
+			for k, v := range rd.Get("data_parameters").(map[string]interface{}) {
+				parameters[k] = v.(string)
+			}
+
+			if _, err := renderWorkbookDataJson(rd.Get("data_json").(string), parameters); err != nil {
+				return fmt.Errorf("rendering `data_json`: %+v", err)
+			}
+
+			return nil
+		},
+	}
 }
 
 func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
@@ -151,6 +204,11 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			serializedData, err := renderWorkbookDataJson(model.DataJson, model.DataParameters)
+			if err != nil {
+				return fmt.Errorf("rendering `data_json`: %+v", err)
+			}
+
 			kindValue := workbooks.WorkbookSharedTypeKindShared
 			properties := &workbooks.Workbook{
 				Identity: identityValue,
@@ -159,7 +217,7 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 				Properties: &workbooks.WorkbookProperties{
 					Category:       model.Category,
 					DisplayName:    model.DisplayName,
-					SerializedData: model.DataJson,
+					SerializedData: serializedData,
 					SourceId:       &model.SourceId,
 				},
 
@@ -225,8 +283,12 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("data_json") {
-				properties.Properties.SerializedData = model.DataJson
+			if metadata.ResourceData.HasChanges("data_json", "data_parameters") {
+				serializedData, err := renderWorkbookDataJson(model.DataJson, model.DataParameters)
+				if err != nil {
+					return fmt.Errorf("rendering `data_json`: %+v", err)
+				}
+				properties.Properties.SerializedData = serializedData
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
@@ -293,6 +355,19 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 
 				state.DataJson = properties.SerializedData
 
+				// when the workbook is rendered from a template, keep the template in state as long as the deployed content still matches it
+				var existing ApplicationInsightsWorkbookModel
+				if err := metadata.Decode(&existing); err == nil && len(existing.DataParameters) > 0 {
+					state.DataParameters = existing.DataParameters
+					if rendered, err := renderWorkbookDataJson(existing.DataJson, existing.DataParameters); err == nil && utils.NormalizeJson(rendered) == utils.NormalizeJson(properties.SerializedData) {
+						state.DataJson = existing.DataJson
+					}
+				}
+
+				// the revision is only exposed so that changes made outside of Terraform can be spotted, the
+				// content is always deployed from `data_json` rather than being pinned to a given revision
+				state.Revision = pointer.From(properties.Revision)
+
 				if properties.SourceId != nil {
 					state.SourceId = *properties.SourceId
 				}
@@ -332,3 +407,36 @@ func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+var workbookDataParameterRegex = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_\-]+)\s*\}\}`)
+
+// renderWorkbookDataJson replaces the `{{name}}` placeholders within the workbook template with the values from
+// `data_parameters` - values are JSON escaped so that they can be used within string values of the template
+func renderWorkbookDataJson(input string, parameters map[string]string) (string, error) {
+	if len(parameters) == 0 {
+		return input, nil
+	}
+
+	missing := make([]string, 0)
+	output := workbookDataParameterRegex.ReplaceAllStringFunc(input, func(placeholder string) string {
+		name := workbookDataParameterRegex.FindStringSubmatch(placeholder)[1]
+		value, ok := parameters[name]
+		if !ok {
+			missing = append(missing, name)
+			return placeholder
+		}
+
+		escaped, _ := json.Marshal(value)
+		return strings.TrimSuffix(strings.TrimPrefix(string(escaped), `"`), `"`)
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("no value was specified in `data_parameters` for the placeholder(s): %s", strings.Join(missing, ", "))
+	}
+
+	if !json.Valid([]byte(output)) {
+		return "", fmt.Errorf("the rendered template is not valid JSON")
+	}
+
+	return output, nil
+}
@@ -62,6 +62,40 @@ func TestAccApplicationInsightsWorkbook_complete(t *testing.T) {
 	})
 }
 
+func TestAccApplicationInsightsWorkbook_dataParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := ApplicationInsightsWorkbookResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataParameters(data, "Test2022"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("revision").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("data_json", "data_parameters"),
+		{
+			Config: r.dataParameters(data, "Test2023"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("revision").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("data_json", "data_parameters"),
+	})
+}
+
+func TestAccApplicationInsightsWorkbook_dataParametersMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := ApplicationInsightsWorkbookResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.dataParametersMissing(data),
+			ExpectError: regexp.MustCompile("no value was specified in `data_parameters`"),
+		},
+	})
+}
+
 func TestAccApplicationInsightsWorkbook_updateDisplayName(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
 	r := ApplicationInsightsWorkbookResource{}
@@ -175,6 +209,72 @@ resource "azurerm_application_insights_workbook" "test" {
 `, template, intValue)
 }
 
+func (r ApplicationInsightsWorkbookResource) dataParameters(data acceptance.TestData, text string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "{{ text }}"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false,
+    "fallbackResourceIds" = [
+      "{{fallback_resource_id}}"
+    ]
+  })
+
+  data_parameters = {
+    text                 = "%s"
+    fallback_resource_id = "Azure Monitor"
+  }
+}
+`, template, data.RandomInteger, text)
+}
+
+func (r ApplicationInsightsWorkbookResource) dataParametersMissing(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "{{text}}"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false,
+  })
+
+  data_parameters = {
+    unused = "value"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r ApplicationInsightsWorkbookResource) hiddenTitleInTags(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	kvValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -25,6 +26,12 @@ import (
 
 const KindASEV3 = "ASEV3"
 
+type CustomDnsSuffixModel struct {
+	DnsSuffix                   string `tfschema:"dns_suffix"`
+	CertificateUrl              string `tfschema:"certificate_url"`
+	KeyVaultReferenceIdentityId string `tfschema:"key_vault_reference_identity_id"`
+}
+
 type ClusterSettingModel struct {
 	Name  string `tfschema:"name"`
 	Value string `tfschema:"value"`
@@ -36,9 +43,11 @@ type AppServiceEnvironmentV3Model struct {
 	SubnetId                           string                            `tfschema:"subnet_id"`
 	AllowNewPrivateEndpointConnections bool                              `tfschema:"allow_new_private_endpoint_connections"`
 	ClusterSetting                     []ClusterSettingModel             `tfschema:"cluster_setting"`
+	CustomDnsSuffix                    []CustomDnsSuffixModel            `tfschema:"custom_dns_suffix"`
 	DedicatedHostCount                 int64                             `tfschema:"dedicated_host_count"`
 	InternalLoadBalancingMode          string                            `tfschema:"internal_load_balancing_mode"`
 	RemoteDebuggingEnabled             bool                              `tfschema:"remote_debugging_enabled"`
+	UpgradePreference                  string                            `tfschema:"upgrade_preference"`
 	ZoneRedundant                      bool                              `tfschema:"zone_redundant"`
 	Tags                               map[string]string                 `tfschema:"tags"`
 	DnsSuffix                          string                            `tfschema:"dns_suffix"`
@@ -49,6 +58,7 @@ type AppServiceEnvironmentV3Model struct {
 	LinuxOutboundIPAddresses           []string                          `tfschema:"linux_outbound_ip_addresses"`
 	Location                           string                            `tfschema:"location"`
 	PricingTier                        string                            `tfschema:"pricing_tier"`
+	UpgradeAvailability                string                            `tfschema:"upgrade_availability"`
 	WindowsOutboundIPAddresses         []string                          `tfschema:"windows_outbound_ip_addresses"`
 }
 
@@ -58,8 +68,6 @@ type AppServiceV3InboundDependencies struct {
 	Ports       []string `tfschema:"ports"`
 }
 
-// (@jackofallops) - Important property missing from the SDK / Swagger that will need to be added later: `upgrade_preference` https://docs.microsoft.com/en-us/azure/app-service/environment/using#upgrade-preference
-
 type AppServiceEnvironmentV3Resource struct{}
 
 var (
@@ -111,6 +119,33 @@ func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schem
 			},
 		},
 
+		"custom_dns_suffix": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_suffix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"certificate_url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: kvValidate.NestedItemIdWithOptionalVersion,
+					},
+
+					"key_vault_reference_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+
 		"dedicated_host_count": {
 			Type:         pluginsdk.TypeInt,
 			Optional:     true,
@@ -138,6 +173,13 @@ func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schem
 			Default:  false,
 		},
 
+		"upgrade_preference": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(appserviceenvironments.UpgradePreferenceNone),
+			ValidateFunc: validation.StringInSlice(appserviceenvironments.PossibleValuesForUpgradePreference(), false),
+		},
+
 		"zone_redundant": {
 			Type:     pluginsdk.TypeBool,
 			ForceNew: true,
@@ -227,6 +269,11 @@ func (r AppServiceEnvironmentV3Resource) Attributes() map[string]*pluginsdk.Sche
 			Computed: true,
 		},
 
+		"upgrade_availability": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
 		"windows_outbound_ip_addresses": {
 			Type:     pluginsdk.TypeList,
 			Computed: true,
@@ -299,7 +346,8 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 					VirtualNetwork: appserviceenvironments.VirtualNetworkProfile{
 						Id: model.SubnetId,
 					},
-					ZoneRedundant: pointer.To(model.ZoneRedundant),
+					UpgradePreference: pointer.To(appserviceenvironments.UpgradePreference(model.UpgradePreference)),
+					ZoneRedundant:     pointer.To(model.ZoneRedundant),
 				},
 				Tags: pointer.To(model.Tags),
 			}
@@ -339,6 +387,13 @@ func (r AppServiceEnvironmentV3Resource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for Network Update for %s to complete: %+v", id, err)
 			}
 
+			// the Custom DNS Suffix can only be configured once the App Service Environment exists
+			if len(model.CustomDnsSuffix) > 0 {
+				if err := updateCustomDnsSuffixConfiguration(ctx, client, id, model.CustomDnsSuffix[0]); err != nil {
+					return err
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -380,8 +435,16 @@ func (r AppServiceEnvironmentV3Resource) Read() sdk.ResourceFunc {
 					state.DnsSuffix = pointer.From(props.DnsSuffix)
 					state.IpSSLAddressCount = pointer.From(props.IPsslAddressCount)
 					state.ZoneRedundant = pointer.From(props.ZoneRedundant)
+					state.UpgradePreference = string(pointer.From(props.UpgradePreference))
+					state.UpgradeAvailability = string(pointer.From(props.UpgradeAvailability))
 				}
 
+				customDnsSuffix, err := client.GetAseCustomDnsSuffixConfiguration(ctx, *id)
+				if err != nil && !response.WasNotFound(customDnsSuffix.HttpResponse) {
+					return fmt.Errorf("reading custom DNS suffix configuration for %s: %+v", *id, err)
+				}
+				state.CustomDnsSuffix = flattenCustomDnsSuffixModel(customDnsSuffix.Model)
+
 				existingNetwork, err := client.GetAseV3NetworkingConfiguration(ctx, *id)
 				if err != nil {
 					return fmt.Errorf("reading network configuration for %s: %+v", *id, err)
@@ -473,6 +536,10 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				model.Properties.ClusterSettings = expandClusterSettingsModel(state.ClusterSetting)
 			}
 
+			if metadata.ResourceData.HasChange("upgrade_preference") {
+				model.Properties.UpgradePreference = pointer.To(appserviceenvironments.UpgradePreference(state.UpgradePreference))
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				model.Tags = pointer.To(state.Tags)
 			}
@@ -511,6 +578,18 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("custom_dns_suffix") {
+				if len(state.CustomDnsSuffix) > 0 {
+					if err := updateCustomDnsSuffixConfiguration(ctx, client, *id, state.CustomDnsSuffix[0]); err != nil {
+						return err
+					}
+				} else {
+					if _, err := client.DeleteAseCustomDnsSuffixConfiguration(ctx, *id); err != nil {
+						return fmt.Errorf("removing custom DNS suffix configuration for %s: %+v", *id, err)
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -551,6 +630,71 @@ func expandClusterSettingsModel(input []ClusterSettingModel) *[]appserviceenviro
 	return &clusterSettings
 }
 
+func flattenCustomDnsSuffixModel(input *appserviceenvironments.CustomDnsSuffixConfiguration) []CustomDnsSuffixModel {
+	if input == nil || input.Properties == nil || pointer.From(input.Properties.DnsSuffix) == "" {
+		return []CustomDnsSuffixModel{}
+	}
+
+	return []CustomDnsSuffixModel{
+		{
+			DnsSuffix:                   pointer.From(input.Properties.DnsSuffix),
+			CertificateUrl:              pointer.From(input.Properties.CertificateUrl),
+			KeyVaultReferenceIdentityId: pointer.From(input.Properties.KeyVaultReferenceIdentity),
+		},
+	}
+}
+
+func updateCustomDnsSuffixConfiguration(ctx context.Context, client *appserviceenvironments.AppServiceEnvironmentsClient, id commonids.AppServiceEnvironmentId, input CustomDnsSuffixModel) error {
+	payload := appserviceenvironments.CustomDnsSuffixConfiguration{
+		Properties: &appserviceenvironments.CustomDnsSuffixConfigurationProperties{
+			DnsSuffix:                 pointer.To(input.DnsSuffix),
+			CertificateUrl:            pointer.To(input.CertificateUrl),
+			KeyVaultReferenceIdentity: pointer.To(input.KeyVaultReferenceIdentityId),
+		},
+	}
+
+	if _, err := client.UpdateAseCustomDnsSuffixConfiguration(ctx, id, payload); err != nil {
+		return fmt.Errorf("setting custom DNS suffix configuration for %s: %+v", id, err)
+	}
+
+	// the certificate is retrieved from the Key Vault asynchronously
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("the custom DNS suffix configuration request context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{string(appserviceenvironments.CustomDnsSuffixProvisioningStateInProgress)},
+		Target:       []string{string(appserviceenvironments.CustomDnsSuffixProvisioningStateSucceeded)},
+		PollInterval: 10 * time.Second,
+		Delay:        10 * time.Second,
+		Timeout:      time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAseCustomDnsSuffixConfiguration(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving custom DNS suffix configuration for %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ProvisioningState == nil {
+				return resp, string(appserviceenvironments.CustomDnsSuffixProvisioningStateInProgress), nil
+			}
+
+			props := resp.Model.Properties
+			switch *props.ProvisioningState {
+			case appserviceenvironments.CustomDnsSuffixProvisioningStateDegraded, appserviceenvironments.CustomDnsSuffixProvisioningStateFailed:
+				return resp, string(*props.ProvisioningState), fmt.Errorf("custom DNS suffix configuration is in state %q: %s", string(*props.ProvisioningState), pointer.From(props.ProvisioningDetails))
+			}
+
+			return resp, string(*props.ProvisioningState), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for custom DNS suffix configuration for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func flattenInboundNetworkDependencies(ctx context.Context, client *appserviceenvironments.AppServiceEnvironmentsClient, id *commonids.AppServiceEnvironmentId) (*[]AppServiceV3InboundDependencies, error) {
 	var results []AppServiceV3InboundDependencies
 	inboundNetworking, err := client.GetInboundNetworkDependenciesEndpointsComplete(ctx, *id)
@@ -147,6 +147,36 @@ func TestAccAppServiceEnvironmentV3_dedicatedHosts(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceEnvironmentV3_customDnsSuffix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_environment_v3", "test")
+	r := AppServiceEnvironmentV3Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customDnsSuffix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_dns_suffix.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (AppServiceEnvironmentV3Resource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AppServiceEnvironmentID(state.ID)
 	if err != nil {
@@ -186,6 +216,7 @@ resource "azurerm_app_service_environment_v3" "test" {
   subnet_id                    = azurerm_subnet.test.id
   internal_load_balancing_mode = "Web, Publishing"
   remote_debugging_enabled     = true
+  upgrade_preference           = "Early"
 
   cluster_setting {
     name  = "InternalEncryption"
@@ -219,6 +250,7 @@ resource "azurerm_app_service_environment_v3" "test" {
   resource_group_name          = azurerm_resource_group.test2.name
   subnet_id                    = azurerm_subnet.test.id
   internal_load_balancing_mode = "Web, Publishing"
+  upgrade_preference           = "Manual"
 
   allow_new_private_endpoint_connections = false
 
@@ -281,6 +313,89 @@ resource "azurerm_app_service_environment_v3" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r AppServiceEnvironmentV3Resource) customDnsSuffix(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    certificate_permissions = ["Create", "Delete", "Get", "Import", "Purge", "Recover", "Update"]
+    secret_permissions      = ["Delete", "Get", "Purge", "Set"]
+  }
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+
+    certificate_permissions = ["Get"]
+    secret_permissions      = ["Get"]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage          = ["digitalSignature", "keyEncipherment"]
+      subject            = "CN=*.acctest%[3]s.example.com"
+      validity_in_months = 12
+
+      subject_alternative_names {
+        dns_names = ["*.acctest%[3]s.example.com", "*.scm.acctest%[3]s.example.com"]
+      }
+    }
+  }
+}
+
+resource "azurerm_app_service_environment_v3" "test" {
+  name                = "acctest-ase-%[2]d"
+  resource_group_name = azurerm_resource_group.test2.name
+  subnet_id           = azurerm_subnet.test.id
+
+  custom_dns_suffix {
+    dns_suffix                      = "acctest%[3]s.example.com"
+    certificate_url                 = azurerm_key_vault_certificate.test.versionless_secret_id
+    key_vault_reference_identity_id = azurerm_user_assigned_identity.test.id
+  }
+}
+`, template, data.RandomInteger, data.RandomString)
+}
+
 func (r AppServiceEnvironmentV3Resource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AppServiceEnvironmentV3UpgradeResource struct{}
+
+type AppServiceEnvironmentV3UpgradeModel struct {
+	AppServiceEnvironmentId string            `tfschema:"app_service_environment_id"`
+	Triggers                map[string]string `tfschema:"triggers"`
+	LastUpgradeInitiated    string            `tfschema:"last_upgrade_initiated"`
+}
+
+var _ sdk.Resource = AppServiceEnvironmentV3UpgradeResource{}
+
+func (r AppServiceEnvironmentV3UpgradeResource) ModelObject() interface{} {
+	return &AppServiceEnvironmentV3UpgradeModel{}
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) ResourceType() string {
+	return "azurerm_app_service_environment_v3_upgrade"
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppServiceEnvironmentUpgradeID
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_service_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AppServiceEnvironmentID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_upgrade_initiated": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// upgrading an App Service Environment can take many hours, as every instance within it is upgraded in turn
+		Timeout: 12 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
+
+			var model AppServiceEnvironmentV3UpgradeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := commonids.ParseAppServiceEnvironmentID(model.AppServiceEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAppServiceEnvironmentUpgradeID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.HostingEnvironmentName, "default")
+
+			locks.ByID(environmentId.ID())
+			defer locks.UnlockByID(environmentId.ID())
+
+			existing, err := client.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *environmentId)
+			}
+
+			// an upgrade can only be initiated when one is available
+			if availability := pointer.From(existing.Model.Properties.UpgradeAvailability); availability != appserviceenvironments.UpgradeAvailabilityReady {
+				return fmt.Errorf("no upgrade is available for %s (`upgrade_availability` is %q)", *environmentId, string(availability))
+			}
+			if pointer.From(existing.Model.Properties.UpgradePreference) != appserviceenvironments.UpgradePreferenceManual {
+				return fmt.Errorf("an upgrade can only be initiated for %s when `upgrade_preference` is set to `%s`", *environmentId, string(appserviceenvironments.UpgradePreferenceManual))
+			}
+
+			metadata.Logger.Infof("initiating upgrade for %s", *environmentId)
+			if err := client.UpgradeThenPoll(ctx, *environmentId); err != nil {
+				return fmt.Errorf("upgrading %s: %+v", *environmentId, err)
+			}
+			model.LastUpgradeInitiated = time.Now().Format(time.RFC3339)
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.AppServiceEnvironmentClient
+
+			id, err := parse.AppServiceEnvironmentUpgradeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			environmentId := commonids.NewAppServiceEnvironmentID(id.SubscriptionId, id.ResourceGroup, id.HostingEnvironmentName)
+
+			existing, err := client.Get(ctx, environmentId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", environmentId, err)
+			}
+
+			var state AppServiceEnvironmentV3UpgradeModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.AppServiceEnvironmentId = environmentId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: an upgrade can't be rolled back, so removing this resource doesn't revert the App Service Environment
+			return nil
+		},
+	}
+}
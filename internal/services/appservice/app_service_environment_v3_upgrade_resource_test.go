@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AppServiceEnvironmentV3UpgradeResource struct{}
+
+func TestAccAppServiceEnvironmentV3Upgrade_noUpgradeAvailable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_environment_v3_upgrade", "test")
+	r := AppServiceEnvironmentV3UpgradeResource{}
+
+	// a newly provisioned App Service Environment is already on the latest version, so there's no upgrade to initiate
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basic(data, "first"),
+			ExpectError: regexp.MustCompile("no upgrade is available"),
+		},
+	})
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.AppServiceEnvironmentUpgradeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentId := commonids.NewAppServiceEnvironmentID(id.SubscriptionId, id.ResourceGroup, id.HostingEnvironmentName)
+	resp, err := client.AppService.AppServiceEnvironmentClient.Get(ctx, environmentId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", environmentId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AppServiceEnvironmentV3UpgradeResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment_v3" "test" {
+  name                = "acctest-ase-%d"
+  resource_group_name = azurerm_resource_group.test2.name
+  subnet_id           = azurerm_subnet.test.id
+  upgrade_preference  = "Manual"
+}
+
+resource "azurerm_app_service_environment_v3_upgrade" "test" {
+  app_service_environment_id = azurerm_app_service_environment_v3.test.id
+
+  triggers = {
+    maintenance_window = "%s"
+  }
+}
+`, AppServiceEnvironmentV3Resource{}.template(data), data.RandomInteger, trigger)
+}
@@ -5,8 +5,12 @@ package appservice
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -32,6 +36,7 @@ type FunctionAppFunctionModel struct {
 	TestData       string          `tfschema:"test_data"`
 	Files          []FunctionFiles `tfschema:"file"`
 
+	ContentHash       string `tfschema:"content_hash"`
 	ConfigURL         string `tfschema:"config_url"`
 	FunctionURL       string `tfschema:"url"`
 	InvokeURL         string `tfschema:"invocation_url"`
@@ -41,11 +46,15 @@ type FunctionAppFunctionModel struct {
 }
 
 type FunctionFiles struct {
-	Name    string `tfschema:"name"`
-	Content string `tfschema:"content"`
+	Name       string `tfschema:"name"`
+	Content    string `tfschema:"content"`
+	SourceFile string `tfschema:"source_file"`
 }
 
-var _ sdk.ResourceWithUpdate = FunctionAppFunctionResource{}
+var (
+	_ sdk.ResourceWithUpdate        = FunctionAppFunctionResource{}
+	_ sdk.ResourceWithCustomizeDiff = FunctionAppFunctionResource{}
+)
 
 func (r FunctionAppFunctionResource) ModelObject() interface{} {
 	return &FunctionAppFunctionModel{}
@@ -78,10 +87,13 @@ func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 		},
 
 		"config_json": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringIsJSON,
-			Description:  "The config for this Function in JSON format.",
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.All(
+				validation.StringIsJSON,
+				validate.FunctionAppFunctionConfigJSON,
+			),
+			Description: "The config for this Function in JSON format.",
 		},
 
 		"enabled": {
@@ -110,23 +122,26 @@ func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
 			MinItems: 1,
-			ForceNew: true,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"name": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ForceNew:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 						Description:  "The filename of the file to be uploaded.",
 					},
 					"content": {
 						Type:         pluginsdk.TypeString,
-						Required:     true,
-						ForceNew:     true,
+						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 						Description:  "The content of the file.",
 					},
+					"source_file": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The path to a local file whose content should be uploaded.",
+					},
 				},
 			},
 		},
@@ -142,6 +157,12 @@ func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 
 func (r FunctionAppFunctionResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the names and contents of the files deployed for this function.",
+		},
+
 		"config_url": {
 			Type:        pluginsdk.TypeString,
 			Computed:    true,
@@ -220,13 +241,18 @@ func (r FunctionAppFunctionResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("error preparing config data to send: %+v", err)
 			}
 
+			files, err := expandFunctionFiles(appFunction.Files)
+			if err != nil {
+				return err
+			}
+
 			fnEnvelope := webapps.FunctionEnvelope{
 				Properties: &webapps.FunctionEnvelopeProperties{
 					Config:     pointer.To(confJSON),
 					TestData:   pointer.To(appFunction.TestData),
 					Language:   pointer.To(appFunction.Language),
 					IsDisabled: pointer.To(!appFunction.Enabled),
-					Files:      expandFunctionFiles(appFunction.Files),
+					Files:      files,
 				},
 			}
 
@@ -266,7 +292,7 @@ func (r FunctionAppFunctionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return metadata.ResourceData.Set("content_hash", functionFilesContentHash(files))
 		},
 	}
 }
@@ -316,13 +342,17 @@ func (r FunctionAppFunctionResource) Read() sdk.ResourceFunc {
 						for _, v := range filesRaw.([]interface{}) {
 							file := v.(map[string]interface{})
 							files = append(files, FunctionFiles{
-								Name:    file["name"].(string),
-								Content: file["content"].(string),
+								Name:       file["name"].(string),
+								Content:    file["content"].(string),
+								SourceFile: file["source_file"].(string),
 							})
 						}
 						appFunc.Files = files
 					}
 
+					// the contents of the files aren't returned by the API, so the hash of what was last deployed is retained
+					appFunc.ContentHash = metadata.ResourceData.Get("content_hash").(string)
+
 					config, err := flattenFunctionFiles(props.Config)
 					if err != nil {
 						return err
@@ -431,6 +461,15 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 				model.Properties.TestData = pointer.To(appFunction.TestData)
 			}
 
+			var files *map[string]string
+			if metadata.ResourceData.HasChanges("file", "content_hash") {
+				files, err = expandFunctionFiles(appFunction.Files)
+				if err != nil {
+					return err
+				}
+				model.Properties.Files = files
+			}
+
 			deadline, ok := ctx.Deadline()
 			if !ok {
 				return fmt.Errorf("internal-error: context had no deadline")
@@ -465,21 +504,111 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if files != nil {
+				return metadata.ResourceData.Set("content_hash", functionFilesContentHash(files))
+			}
+
 			return nil
 		},
 	}
 }
 
-func expandFunctionFiles(input []FunctionFiles) *map[string]string {
-	if input == nil {
-		return nil
+func (r FunctionAppFunctionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			filesRaw := rd.Get("file").([]interface{})
+			for i, v := range filesRaw {
+				file, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if !rd.NewValueKnown(fmt.Sprintf("file.%d.content", i)) || !rd.NewValueKnown(fmt.Sprintf("file.%d.source_file", i)) {
+					continue
+				}
+				content := file["content"].(string)
+				sourceFile := file["source_file"].(string)
+				if (content == "") == (sourceFile == "") {
+					return fmt.Errorf("exactly one of `content` or `source_file` must be specified for the file %q", file["name"].(string))
+				}
+			}
+
+			// the hash is only recalculated once the files are known, since `source_file` may refer to a file built during the apply
+			if !rd.NewValueKnown("file") {
+				return rd.SetNewComputed("content_hash")
+			}
+
+			files := make([]FunctionFiles, 0)
+			for _, v := range filesRaw {
+				file, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				files = append(files, FunctionFiles{
+					Name:       file["name"].(string),
+					Content:    file["content"].(string),
+					SourceFile: file["source_file"].(string),
+				})
+			}
+
+			expanded, err := expandFunctionFiles(files)
+			if err != nil {
+				return err
+			}
+
+			if hash := functionFilesContentHash(expanded); hash != rd.Get("content_hash").(string) {
+				return rd.SetNew("content_hash", hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandFunctionFiles(input []FunctionFiles) (*map[string]string, error) {
+	if len(input) == 0 {
+		return nil, nil
 	}
 	result := make(map[string]string)
 	for _, v := range input {
-		result[v.Name] = v.Content
+		content := v.Content
+		if v.SourceFile != "" {
+			raw, err := os.ReadFile(v.SourceFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading `source_file` %q for the file %q: %+v", v.SourceFile, v.Name, err)
+			}
+			content = string(raw)
+		}
+		result[v.Name] = content
+	}
+
+	return &result, nil
+}
+
+// functionFilesContentHash returns a SHA256 hash of the names and contents of the files, which is used to detect
+// changes to the deployed files (e.g. when the content of a `source_file` changes)
+func functionFilesContentHash(input *map[string]string) string {
+	if input == nil || len(*input) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0)
+	for name := range *input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name))
+		hash.Write([]byte{0})
+		hash.Write([]byte((*input)[name]))
+		hash.Write([]byte{0})
 	}
 
-	return &result
+	return hex.EncodeToString(hash.Sum(nil))
 }
 
 func flattenFunctionFiles(input interface{}) (*string, error) {
@@ -82,7 +82,31 @@ func TestAccFunctionAppFunction_withFiles(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("language", "file"),
+		data.ImportStep("language", "file", "content_hash"),
+	})
+}
+
+func TestAccFunctionAppFunction_withSourceFiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function", "test")
+	r := FunctionAppFunctionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withLocalFiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("language", "file", "content_hash"),
+		{
+			Config: r.withSourceFiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("language", "file", "content_hash"),
 	})
 }
 
@@ -225,6 +249,52 @@ resource "azurerm_function_app_function" "test" {
 `, r.templateWindows(data), data.RandomInteger)
 }
 
+func (r FunctionAppFunctionResource) withSourceFiles(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_function" "test" {
+  name            = "testAcc-FnAppFn-%[2]d"
+  function_app_id = azurerm_windows_function_app.test.id
+  language        = "CSharp"
+  file {
+    name        = "run.csx"
+    source_file = "testdata/run.csx"
+  }
+  file {
+    name        = "host.json"
+    source_file = "testdata/host.json"
+  }
+  test_data = jsonencode({
+    "name" = "Azure"
+  })
+  config_json = jsonencode({
+    "bindings" = [
+      {
+        "authLevel" = "function"
+        "direction" = "in"
+        "methods" = [
+          "get",
+          "post",
+        ]
+        "name" = "req"
+        "type" = "httpTrigger"
+      },
+      {
+        "direction" = "out"
+        "name"      = "$return"
+        "type"      = "http"
+      },
+    ]
+  })
+}
+`, r.templateWindows(data), data.RandomInteger)
+}
+
 func (r FunctionAppFunctionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
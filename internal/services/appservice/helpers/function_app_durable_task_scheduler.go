@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	// DurableTaskSchedulerConnectionStringSetting and DurableTaskHubNameSetting are the App Settings referenced by the
+	// `azureManaged` storage provider within the `durableTask` extension in the `host.json` of the Function App
+	DurableTaskSchedulerConnectionStringSetting = "DURABLE_TASK_SCHEDULER_CONNECTION_STRING"
+	DurableTaskHubNameSetting                   = "TASKHUB_NAME"
+)
+
+type DurableTaskScheduler struct {
+	Endpoint                     string `tfschema:"endpoint"`
+	TaskHubName                  string `tfschema:"task_hub_name"`
+	UserAssignedIdentityClientId string `tfschema:"user_assigned_identity_client_id"`
+}
+
+func DurableTaskSchedulerSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The endpoint of the Durable Task Scheduler.",
+				},
+
+				"task_hub_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Task Hub within the Durable Task Scheduler.",
+				},
+
+				"user_assigned_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+					Description:  "The Client ID of the User Assigned Identity used to connect to the Durable Task Scheduler. Defaults to the System Assigned Identity of the Function App.",
+				},
+			},
+		},
+	}
+}
+
+// ExpandDurableTaskSchedulerAppSettings adds the App Settings used to connect to the Durable Task Scheduler to `appSettings`,
+// unless they've been explicitly specified by the user
+func ExpandDurableTaskSchedulerAppSettings(input []DurableTaskScheduler, appSettings map[string]string) map[string]string {
+	if len(input) == 0 {
+		return appSettings
+	}
+
+	if appSettings == nil {
+		appSettings = make(map[string]string)
+	}
+
+	config := input[0]
+	connectionString := fmt.Sprintf("Endpoint=%s;Authentication=ManagedIdentity", config.Endpoint)
+	if config.UserAssignedIdentityClientId != "" {
+		connectionString = fmt.Sprintf("%s;ClientID=%s", connectionString, config.UserAssignedIdentityClientId)
+	}
+
+	if _, ok := appSettings[DurableTaskSchedulerConnectionStringSetting]; !ok {
+		appSettings[DurableTaskSchedulerConnectionStringSetting] = connectionString
+	}
+	if _, ok := appSettings[DurableTaskHubNameSetting]; !ok {
+		appSettings[DurableTaskHubNameSetting] = config.TaskHubName
+	}
+
+	return appSettings
+}
+
+// ParseDurableTaskSchedulerConnectionString returns the endpoint and (optional) User Assigned Identity Client ID
+// from a Durable Task Scheduler connection string
+func ParseDurableTaskSchedulerConnectionString(input string) (endpoint, clientId string) {
+	for _, part := range strings.Split(input, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "endpoint":
+			endpoint = value
+		case "clientid":
+			clientId = value
+		}
+	}
+
+	return
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+func TestExpandDurableTaskSchedulerAppSettings(t *testing.T) {
+	cases := []struct {
+		input       []helpers.DurableTaskScheduler
+		appSettings map[string]string
+		expected    map[string]string
+	}{
+		{
+			input:       []helpers.DurableTaskScheduler{},
+			appSettings: nil,
+			expected:    nil,
+		},
+		{
+			input: []helpers.DurableTaskScheduler{
+				{
+					Endpoint:    "https://example.westeurope.durabletask.io",
+					TaskHubName: "example",
+				},
+			},
+			appSettings: map[string]string{
+				"foo": "bar",
+			},
+			expected: map[string]string{
+				"foo": "bar",
+				"DURABLE_TASK_SCHEDULER_CONNECTION_STRING": "Endpoint=https://example.westeurope.durabletask.io;Authentication=ManagedIdentity",
+				"TASKHUB_NAME": "example",
+			},
+		},
+		{
+			input: []helpers.DurableTaskScheduler{
+				{
+					Endpoint:                     "https://example.westeurope.durabletask.io",
+					TaskHubName:                  "example",
+					UserAssignedIdentityClientId: "00000000-0000-0000-0000-000000000000",
+				},
+			},
+			appSettings: nil,
+			expected: map[string]string{
+				"DURABLE_TASK_SCHEDULER_CONNECTION_STRING": "Endpoint=https://example.westeurope.durabletask.io;Authentication=ManagedIdentity;ClientID=00000000-0000-0000-0000-000000000000",
+				"TASKHUB_NAME": "example",
+			},
+		},
+		{
+			// explicitly specified app settings take priority
+			input: []helpers.DurableTaskScheduler{
+				{
+					Endpoint:    "https://example.westeurope.durabletask.io",
+					TaskHubName: "example",
+				},
+			},
+			appSettings: map[string]string{
+				"TASKHUB_NAME": "other",
+			},
+			expected: map[string]string{
+				"DURABLE_TASK_SCHEDULER_CONNECTION_STRING": "Endpoint=https://example.westeurope.durabletask.io;Authentication=ManagedIdentity",
+				"TASKHUB_NAME": "other",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := helpers.ExpandDurableTaskSchedulerAppSettings(c.input, c.appSettings)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected %+v but got %+v", c.expected, actual)
+		}
+	}
+}
+
+func TestParseDurableTaskSchedulerConnectionString(t *testing.T) {
+	cases := []struct {
+		input            string
+		expectedEndpoint string
+		expectedClientId string
+	}{
+		{
+			input: "",
+		},
+		{
+			input:            "Endpoint=https://example.westeurope.durabletask.io;Authentication=ManagedIdentity",
+			expectedEndpoint: "https://example.westeurope.durabletask.io",
+		},
+		{
+			input:            "Endpoint=https://example.westeurope.durabletask.io;Authentication=ManagedIdentity;ClientID=00000000-0000-0000-0000-000000000000",
+			expectedEndpoint: "https://example.westeurope.durabletask.io",
+			expectedClientId: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, c := range cases {
+		endpoint, clientId := helpers.ParseDurableTaskSchedulerConnectionString(c.input)
+		if endpoint != c.expectedEndpoint || clientId != c.expectedClientId {
+			t.Fatalf("expected %q / %q but got %q / %q", c.expectedEndpoint, c.expectedClientId, endpoint, clientId)
+		}
+	}
+}
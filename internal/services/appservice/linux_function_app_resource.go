@@ -51,6 +51,7 @@ type LinuxFunctionAppModel struct {
 	AuthV2Settings                   []helpers.AuthV2Settings                   `tfschema:"auth_settings_v2"`
 	Backup                           []helpers.Backup                           `tfschema:"backup"` // Not supported on Dynamic or Basic plans
 	BuiltinLogging                   bool                                       `tfschema:"builtin_logging_enabled"`
+	DurableTaskScheduler             []helpers.DurableTaskScheduler             `tfschema:"durable_task_scheduler"`
 	ClientCertEnabled                bool                                       `tfschema:"client_certificate_enabled"`
 	ClientCertMode                   string                                     `tfschema:"client_certificate_mode"`
 	ClientCertExclusionPaths         string                                     `tfschema:"client_certificate_exclusion_paths"`
@@ -192,6 +193,8 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Description: "Should built in logging be enabled. Configures `AzureWebJobsDashboard` app setting based on the configured storage setting",
 		},
 
+		"durable_task_scheduler": helpers.DurableTaskSchedulerSchema(),
+
 		"client_certificate_enabled": {
 			Type:        pluginsdk.TypeBool,
 			Optional:    true,
@@ -502,6 +505,8 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			siteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(functionApp.SiteConfig[0].ApplicationStack)
+			functionApp.AppSettings = helpers.ExpandDurableTaskSchedulerAppSettings(functionApp.DurableTaskScheduler, functionApp.AppSettings)
+
 			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionApp.AppSettings)
 
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(functionApp.Identity)
@@ -1031,6 +1036,8 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				model.Properties.SiteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(state.SiteConfig[0].ApplicationStack)
 			}
 
+			state.AppSettings = helpers.ExpandDurableTaskSchedulerAppSettings(state.DurableTaskScheduler, state.AppSettings)
+
 			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
@@ -1289,6 +1296,7 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input webapps.Str
 	appSettings := make(map[string]string)
 	var dockerSettings helpers.ApplicationStackDocker
 	m.BuiltinLogging = false
+	var durableTaskScheduler helpers.DurableTaskScheduler
 
 	for k, v := range *input.Properties {
 		switch k {
@@ -1354,6 +1362,20 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input webapps.Str
 		case "AzureWebJobsDashboard__accountName":
 			m.BuiltinLogging = true
 
+		case helpers.DurableTaskSchedulerConnectionStringSetting:
+			if _, ok := metadata.ResourceData.GetOk(fmt.Sprintf("app_settings.%s", k)); ok {
+				appSettings[k] = v
+			} else {
+				durableTaskScheduler.Endpoint, durableTaskScheduler.UserAssignedIdentityClientId = helpers.ParseDurableTaskSchedulerConnectionString(v)
+			}
+
+		case helpers.DurableTaskHubNameSetting:
+			if _, ok := metadata.ResourceData.GetOk(fmt.Sprintf("app_settings.%s", k)); ok {
+				appSettings[k] = v
+			} else {
+				durableTaskScheduler.TaskHubName = v
+			}
+
 		case "WEBSITE_VNET_ROUTE_ALL":
 			// Filter out - handled by site_config setting `vnet_route_all_enabled`
 		default:
@@ -1368,6 +1390,13 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input webapps.Str
 		m.SiteConfig[0].ApplicationStack = appStack
 	}
 
+	// the Task Hub Name is only part of the `durable_task_scheduler` block when a connection to a Durable Task Scheduler is configured
+	if durableTaskScheduler.Endpoint != "" {
+		m.DurableTaskScheduler = []helpers.DurableTaskScheduler{durableTaskScheduler}
+	} else if durableTaskScheduler.TaskHubName != "" {
+		appSettings[helpers.DurableTaskHubNameSetting] = durableTaskScheduler.TaskHubName
+	}
+
 	m.AppSettings = appSettings
 }
 
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AppServiceEnvironmentUpgradeId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	HostingEnvironmentName string
+	UpgradeName            string
+}
+
+func NewAppServiceEnvironmentUpgradeID(subscriptionId, resourceGroup, hostingEnvironmentName, upgradeName string) AppServiceEnvironmentUpgradeId {
+	return AppServiceEnvironmentUpgradeId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		HostingEnvironmentName: hostingEnvironmentName,
+		UpgradeName:            upgradeName,
+	}
+}
+
+func (id AppServiceEnvironmentUpgradeId) String() string {
+	segments := []string{
+		fmt.Sprintf("Upgrade Name %q", id.UpgradeName),
+		fmt.Sprintf("Hosting Environment Name %q", id.HostingEnvironmentName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "App Service Environment Upgrade", segmentsStr)
+}
+
+func (id AppServiceEnvironmentUpgradeId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/hostingEnvironments/%s/upgrade/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostingEnvironmentName, id.UpgradeName)
+}
+
+// AppServiceEnvironmentUpgradeID parses a AppServiceEnvironmentUpgrade ID into an AppServiceEnvironmentUpgradeId struct
+func AppServiceEnvironmentUpgradeID(input string) (*AppServiceEnvironmentUpgradeId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an AppServiceEnvironmentUpgrade ID: %+v", input, err)
+	}
+
+	resourceId := AppServiceEnvironmentUpgradeId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.HostingEnvironmentName, err = id.PopSegment("hostingEnvironments"); err != nil {
+		return nil, err
+	}
+	if resourceId.UpgradeName, err = id.PopSegment("upgrade"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// AppServiceEnvironmentUpgradeIDInsensitively parses an AppServiceEnvironmentUpgrade ID into an AppServiceEnvironmentUpgradeId struct, insensitively
+// This should only be used to parse an ID for rewriting, the AppServiceEnvironmentUpgradeID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func AppServiceEnvironmentUpgradeIDInsensitively(input string) (*AppServiceEnvironmentUpgradeId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AppServiceEnvironmentUpgradeId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'hostingEnvironments' segment
+	hostingEnvironmentsKey := "hostingEnvironments"
+	for key := range id.Path {
+		if strings.EqualFold(key, hostingEnvironmentsKey) {
+			hostingEnvironmentsKey = key
+			break
+		}
+	}
+	if resourceId.HostingEnvironmentName, err = id.PopSegment(hostingEnvironmentsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'upgrade' segment
+	upgradeKey := "upgrade"
+	for key := range id.Path {
+		if strings.EqualFold(key, upgradeKey) {
+			upgradeKey = key
+			break
+		}
+	}
+	if resourceId.UpgradeName, err = id.PopSegment(upgradeKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -39,6 +39,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceEnvironmentV3Resource{},
+		AppServiceEnvironmentV3UpgradeResource{},
 		AppServiceSourceControlTokenResource{},
 		FunctionAppActiveSlotResource{},
 		FunctionAppFunctionResource{},
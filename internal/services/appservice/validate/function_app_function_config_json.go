@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// functionTriggerRequiredProperties lists the properties which must be specified for the well-known trigger types
+var functionTriggerRequiredProperties = map[string][]string{
+	"blobtrigger":     {"path"},
+	"eventhubtrigger": {"eventHubName"},
+	"queuetrigger":    {"queueName"},
+	"timertrigger":    {"schedule"},
+}
+
+// FunctionAppFunctionConfigJSON validates that the `config_json` of a Function is a JSON object whose `bindings` is a
+// list of objects. Since the Functions runtime accepts (and the API doesn't validate) other bindings, the checks that
+// each binding specifies a `type`, `name` and `direction` and that there's exactly one trigger only return warnings.
+func FunctionAppFunctionConfigJSON(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	var config struct {
+		Bindings []map[string]interface{} `json:"bindings"`
+	}
+	if err := json.Unmarshal([]byte(v), &config); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object containing a `bindings` list: %+v", key, err))
+		return
+	}
+
+	if len(config.Bindings) == 0 {
+		warnings = append(warnings, fmt.Sprintf("%q doesn't contain any `bindings`, so the Function can't be triggered", key))
+		return
+	}
+
+	triggers := 0
+	for i, binding := range config.Bindings {
+		bindingType, _ := binding["type"].(string)
+		if bindingType == "" {
+			warnings = append(warnings, fmt.Sprintf("%q: `bindings.%d.type` should be specified", key, i))
+			continue
+		}
+		if name, _ := binding["name"].(string); name == "" {
+			warnings = append(warnings, fmt.Sprintf("%q: `bindings.%d.name` should be specified for the %q binding", key, i, bindingType))
+		}
+
+		direction, _ := binding["direction"].(string)
+		switch strings.ToLower(direction) {
+		case "in", "out", "inout":
+		default:
+			warnings = append(warnings, fmt.Sprintf("%q: `bindings.%d.direction` should be one of `in`, `out` or `inout` for the %q binding, got %q", key, i, bindingType, direction))
+		}
+
+		if !strings.HasSuffix(strings.ToLower(bindingType), "trigger") {
+			continue
+		}
+
+		triggers++
+		if !strings.EqualFold(direction, "in") {
+			warnings = append(warnings, fmt.Sprintf("%q: the %q trigger at `bindings.%d` should have a `direction` of `in`", key, bindingType, i))
+		}
+
+		for _, property := range functionTriggerRequiredProperties[strings.ToLower(bindingType)] {
+			if value, ok := binding[property]; !ok || value == "" {
+				warnings = append(warnings, fmt.Sprintf("%q: `bindings.%d.%s` should be specified for the %q trigger", key, i, property, bindingType))
+			}
+		}
+
+		if strings.EqualFold(bindingType, "serviceBusTrigger") {
+			_, hasQueue := binding["queueName"]
+			_, hasTopic := binding["topicName"]
+			_, hasSubscription := binding["subscriptionName"]
+			switch {
+			case hasQueue && hasTopic:
+				warnings = append(warnings, fmt.Sprintf("%q: only one of `queueName` or `topicName` should be specified for the %q trigger at `bindings.%d`", key, bindingType, i))
+			case hasTopic && !hasSubscription:
+				warnings = append(warnings, fmt.Sprintf("%q: `bindings.%d.subscriptionName` should be specified when `topicName` is set for the %q trigger", key, i, bindingType))
+			case !hasQueue && !hasTopic:
+				warnings = append(warnings, fmt.Sprintf("%q: one of `queueName` or `topicName` should be specified for the %q trigger at `bindings.%d`", key, bindingType, i))
+			}
+		}
+	}
+
+	if triggers != 1 {
+		warnings = append(warnings, fmt.Sprintf("%q should contain exactly one trigger within `bindings`, got %d", key, triggers))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+)
+
+func TestFunctionAppFunctionConfigJSON(t *testing.T) {
+	cases := []struct {
+		Input   string
+		Valid   bool
+		Warning bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: `[]`,
+			Valid: false,
+		},
+		{
+			Input: `{"bindings": "httpTrigger"}`,
+			Valid: false,
+		},
+		{
+			Input: `{"bindings": ["httpTrigger"]}`,
+			Valid: false,
+		},
+		{
+			Input:   `{}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			Input:   `{"bindings": []}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// http trigger with output binding
+			Input: `{"bindings": [{"authLevel": "function", "direction": "in", "methods": ["get", "post"], "name": "req", "type": "httpTrigger"}, {"direction": "out", "name": "$return", "type": "http"}]}`,
+			Valid: true,
+		},
+		{
+			// missing trigger
+			Input:   `{"bindings": [{"direction": "out", "name": "$return", "type": "http"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// multiple triggers
+			Input:   `{"bindings": [{"direction": "in", "name": "req", "type": "httpTrigger"}, {"direction": "in", "name": "timer", "type": "timerTrigger", "schedule": "0 */5 * * * *"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// trigger with outbound direction
+			Input:   `{"bindings": [{"direction": "out", "name": "req", "type": "httpTrigger"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// missing name
+			Input:   `{"bindings": [{"direction": "in", "type": "httpTrigger"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// invalid direction
+			Input:   `{"bindings": [{"direction": "sideways", "name": "req", "type": "httpTrigger"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			Input: `{"bindings": [{"direction": "in", "name": "timer", "type": "timerTrigger", "schedule": "0 */5 * * * *"}]}`,
+			Valid: true,
+		},
+		{
+			// timer trigger without schedule
+			Input:   `{"bindings": [{"direction": "in", "name": "timer", "type": "timerTrigger"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			Input: `{"bindings": [{"direction": "in", "name": "item", "type": "queueTrigger", "queueName": "example", "connection": "AzureWebJobsStorage"}]}`,
+			Valid: true,
+		},
+		{
+			// queue trigger without queue name
+			Input:   `{"bindings": [{"direction": "in", "name": "item", "type": "queueTrigger"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			Input: `{"bindings": [{"direction": "in", "name": "message", "type": "serviceBusTrigger", "topicName": "example", "subscriptionName": "example"}]}`,
+			Valid: true,
+		},
+		{
+			// service bus topic without subscription
+			Input:   `{"bindings": [{"direction": "in", "name": "message", "type": "serviceBusTrigger", "topicName": "example"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+		{
+			// service bus trigger with both a queue and a topic
+			Input:   `{"bindings": [{"direction": "in", "name": "message", "type": "serviceBusTrigger", "queueName": "example", "topicName": "example", "subscriptionName": "example"}]}`,
+			Valid:   true,
+			Warning: true,
+		},
+	}
+
+	for _, tc := range cases {
+		warnings, errs := validate.FunctionAppFunctionConfigJSON(tc.Input, "test")
+		valid := len(errs) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("expected %s to be %t, got %t: %+v", tc.Input, tc.Valid, valid, errs)
+		}
+		if warning := len(warnings) > 0; warning != tc.Warning {
+			t.Fatalf("expected %s to return warnings %t, got %t: %+v", tc.Input, tc.Warning, warning, warnings)
+		}
+	}
+}
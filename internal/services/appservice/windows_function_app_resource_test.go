@@ -858,6 +858,36 @@ func TestAccWindowsFunctionApp_appServiceLoggingUpdate(t *testing.T) {
 	})
 }
 
+func TestAccWindowsFunctionApp_durableTaskScheduler(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_function_app", "test")
+	r := WindowsFunctionAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityUserAssigned(data, SkuStandardPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.durableTaskScheduler(data, SkuStandardPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.%").HasValue("0"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.identityUserAssigned(data, SkuStandardPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
 func TestAccWindowsFunctionApp_identity(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_windows_function_app", "test")
 	r := WindowsFunctionAppResource{}
@@ -3130,6 +3160,81 @@ resource "azurerm_windows_function_app" "test" {
 `, r.identityTemplate(data, planSku), data.RandomInteger)
 }
 
+func (r WindowsFunctionAppResource) durableTaskScheduler(data acceptance.TestData, planSku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-dts-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.DurableTask/schedulers",
+      "apiVersion": "2025-11-01",
+      "name": "acctest-dts-%[2]d",
+      "location": "${azurerm_resource_group.test.location}",
+      "properties": {
+        "ipAllowlist": ["0.0.0.0/0"],
+        "sku": {
+          "name": "Consumption"
+        }
+      }
+    },
+    {
+      "type": "Microsoft.DurableTask/schedulers/taskHubs",
+      "apiVersion": "2025-11-01",
+      "name": "acctest-dts-%[2]d/acctest-th-%[2]d",
+      "dependsOn": [
+        "[resourceId('Microsoft.DurableTask/schedulers', 'acctest-dts-%[2]d')]"
+      ],
+      "properties": {}
+    }
+  ],
+  "outputs": {
+    "endpoint": {
+      "type": "string",
+      "value": "[reference(resourceId('Microsoft.DurableTask/schedulers', 'acctest-dts-%[2]d'), '2025-11-01').endpoint]"
+    }
+  }
+}
+TEMPLATE
+}
+
+resource "azurerm_windows_function_app" "test" {
+  name                = "acctest-WFA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {}
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  durable_task_scheduler {
+    endpoint                         = jsondecode(azurerm_resource_group_template_deployment.test.output_content).endpoint.value
+    task_hub_name                    = "acctest-th-%[2]d"
+    user_assigned_identity_client_id = azurerm_user_assigned_identity.test.client_id
+  }
+}
+`, r.identityTemplate(data, planSku), data.RandomInteger)
+}
+
 func (r WindowsFunctionAppResource) identityUserAssignedKeyVaultIdentity(data acceptance.TestData, planSku string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
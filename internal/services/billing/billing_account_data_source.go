@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingaccount"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type BillingAccountDataSource struct{}
+
+var _ sdk.DataSource = BillingAccountDataSource{}
+
+type BillingAccountDataSourceModel struct {
+	Name          string `tfschema:"name"`
+	AccountStatus string `tfschema:"account_status"`
+	AccountType   string `tfschema:"account_type"`
+	AgreementType string `tfschema:"agreement_type"`
+	DisplayName   string `tfschema:"display_name"`
+}
+
+func (BillingAccountDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (BillingAccountDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"account_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"account_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"agreement_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (BillingAccountDataSource) ModelObject() interface{} {
+	return &BillingAccountDataSourceModel{}
+}
+
+func (BillingAccountDataSource) ResourceType() string {
+	return "azurerm_billing_account"
+}
+
+func (BillingAccountDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.BillingAccountClient
+
+			var state BillingAccountDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := billingaccount.NewBillingAccountID(state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AccountStatus = string(pointer.From(props.AccountStatus))
+					state.AccountType = string(pointer.From(props.AccountType))
+					state.AgreementType = string(pointer.From(props.AgreementType))
+					state.DisplayName = pointer.From(props.DisplayName)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/invoicesection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type BillingInvoiceSectionDataSource struct{}
+
+var _ sdk.DataSource = BillingInvoiceSectionDataSource{}
+
+type BillingInvoiceSectionDataSourceModel struct {
+	Name               string            `tfschema:"name"`
+	BillingAccountName string            `tfschema:"billing_account_name"`
+	BillingProfileName string            `tfschema:"billing_profile_name"`
+	DisplayName        string            `tfschema:"display_name"`
+	State              string            `tfschema:"state"`
+	Tags               map[string]string `tfschema:"tags"`
+}
+
+func (BillingInvoiceSectionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"billing_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"billing_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (BillingInvoiceSectionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (BillingInvoiceSectionDataSource) ModelObject() interface{} {
+	return &BillingInvoiceSectionDataSourceModel{}
+}
+
+func (BillingInvoiceSectionDataSource) ResourceType() string {
+	return "azurerm_billing_invoice_section"
+}
+
+func (BillingInvoiceSectionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.InvoiceSectionClient
+
+			var state BillingInvoiceSectionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := invoicesection.NewInvoiceSectionID(state.BillingAccountName, state.BillingProfileName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.State = string(pointer.From(props.State))
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type BillingProfileDataSource struct{}
+
+var _ sdk.DataSource = BillingProfileDataSource{}
+
+type BillingProfileDataSourceModel struct {
+	Name               string   `tfschema:"name"`
+	BillingAccountName string   `tfschema:"billing_account_name"`
+	Currency           string   `tfschema:"currency"`
+	DisplayName        string   `tfschema:"display_name"`
+	InvoiceDay         int64    `tfschema:"invoice_day"`
+	InvoiceEmailOptIn  bool     `tfschema:"invoice_email_opt_in"`
+	InvoiceRecipients  []string `tfschema:"invoice_recipients"`
+	PoNumber           string   `tfschema:"po_number"`
+	Status             string   `tfschema:"status"`
+}
+
+func (BillingProfileDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"billing_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (BillingProfileDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"currency": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"invoice_day": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"invoice_email_opt_in": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"invoice_recipients": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"po_number": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (BillingProfileDataSource) ModelObject() interface{} {
+	return &BillingProfileDataSourceModel{}
+}
+
+func (BillingProfileDataSource) ResourceType() string {
+	return "azurerm_billing_profile"
+}
+
+func (BillingProfileDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.BillingProfileClient
+
+			var state BillingProfileDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := billingprofile.NewBillingProfileID(state.BillingAccountName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Currency = pointer.From(props.Currency)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.InvoiceDay = pointer.From(props.InvoiceDay)
+					state.InvoiceEmailOptIn = pointer.From(props.InvoiceEmailOptIn)
+					state.InvoiceRecipients = pointer.From(props.InvoiceRecipients)
+					state.PoNumber = pointer.From(props.PoNumber)
+					state.Status = string(pointer.From(props.Status))
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
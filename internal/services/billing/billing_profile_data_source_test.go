@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type BillingProfileDataSource struct{}
+
+func TestAccBillingProfileDataSource_basic(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" || os.Getenv("ARM_BILLING_PROFILE") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_billing_profile", "test")
+	r := BillingProfileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("currency").Exists(),
+			),
+		},
+	})
+}
+
+func (BillingProfileDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_profile" "test" {
+  name                 = "%s"
+  billing_account_name = "%s"
+}
+`, os.Getenv("ARM_BILLING_PROFILE"), os.Getenv("ARM_BILLING_ACCOUNT"))
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingsubscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/invoicesection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type BillingSubscriptionInvoiceSectionResource struct{}
+
+type BillingSubscriptionInvoiceSectionModel struct {
+	BillingAccountName string `tfschema:"billing_account_name"`
+	SubscriptionId     string `tfschema:"subscription_id"`
+	InvoiceSectionId   string `tfschema:"invoice_section_id"`
+
+	BillingProfileId string `tfschema:"billing_profile_id"`
+}
+
+var _ sdk.ResourceWithUpdate = BillingSubscriptionInvoiceSectionResource{}
+
+func (r BillingSubscriptionInvoiceSectionResource) ModelObject() interface{} {
+	return &BillingSubscriptionInvoiceSectionModel{}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) ResourceType() string {
+	return "azurerm_billing_subscription_invoice_section"
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return billingsubscription.ValidateBillingAccountBillingSubscriptionID
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"billing_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"invoice_section_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: invoicesection.ValidateInvoiceSectionID,
+		},
+	}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"billing_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.BillingSubscriptionClient
+
+			var config BillingSubscriptionInvoiceSectionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId, err := commonids.ParseSubscriptionID(config.SubscriptionId)
+			if err != nil {
+				return err
+			}
+
+			id := billingsubscription.NewBillingAccountBillingSubscriptionID(config.BillingAccountName, subscriptionId.SubscriptionId)
+
+			existing, err := client.Get(ctx, id, billingsubscription.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the subscription already exists, so this resource only manages which invoice section it's billed to
+			if err := moveBillingSubscription(ctx, client, id, existing.Model, config.InvoiceSectionId); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.BillingSubscriptionClient
+
+			id, err := billingsubscription.ParseBillingAccountBillingSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, billingsubscription.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := BillingSubscriptionInvoiceSectionModel{
+				BillingAccountName: id.BillingAccountName,
+				SubscriptionId:     commonids.NewSubscriptionID(id.BillingSubscriptionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if v := pointer.From(props.InvoiceSectionId); v != "" {
+						invoiceSectionId, err := invoicesection.ParseInvoiceSectionIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.InvoiceSectionId = invoiceSectionId.ID()
+					}
+					state.BillingProfileId = pointer.From(props.BillingProfileId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.BillingSubscriptionClient
+
+			id, err := billingsubscription.ParseBillingAccountBillingSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config BillingSubscriptionInvoiceSectionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("invoice_section_id") {
+				existing, err := client.Get(ctx, *id, billingsubscription.DefaultGetOperationOptions())
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				if err := moveBillingSubscription(ctx, client, *id, existing.Model, config.InvoiceSectionId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BillingSubscriptionInvoiceSectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := billingsubscription.ParseBillingAccountBillingSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a subscription must always be billed to an invoice section, so it's left where it is
+			log.Printf("[DEBUG] %s will remain in its current Invoice Section and is only being removed from the state", *id)
+
+			return nil
+		},
+	}
+}
+
+func moveBillingSubscription(ctx context.Context, client *billingsubscription.BillingSubscriptionClient, id billingsubscription.BillingAccountBillingSubscriptionId, existing *billingsubscription.BillingSubscription, invoiceSectionId string) error {
+	if existing != nil && existing.Properties != nil && strings.EqualFold(pointer.From(existing.Properties.InvoiceSectionId), invoiceSectionId) {
+		return nil
+	}
+
+	payload := billingsubscription.MoveBillingSubscriptionRequest{
+		DestinationInvoiceSectionId: pointer.To(invoiceSectionId),
+	}
+
+	eligibility, err := client.ValidateMoveEligibility(ctx, id, payload)
+	if err != nil {
+		return fmt.Errorf("validating whether %s can be moved to %q: %+v", id, invoiceSectionId, err)
+	}
+	if model := eligibility.Model; model != nil && !pointer.From(model.IsMoveEligible) {
+		message := "no reason was returned"
+		if details := model.ErrorDetails; details != nil {
+			message = fmt.Sprintf("%s: %s", string(pointer.From(details.Code)), pointer.From(details.Message))
+		}
+		return fmt.Errorf("%s cannot be moved to %q: %s", id, invoiceSectionId, message)
+	}
+
+	if err := client.MoveThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("moving %s to %q: %+v", id, invoiceSectionId, err)
+	}
+
+	return nil
+}
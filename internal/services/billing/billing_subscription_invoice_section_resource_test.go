@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingsubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BillingSubscriptionInvoiceSectionResource struct{}
+
+func TestAccBillingSubscriptionInvoiceSection_basic(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" || os.Getenv("ARM_BILLING_PROFILE") == "" || os.Getenv("ARM_INVOICE_SECTION") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_billing_subscription_invoice_section", "test")
+	r := BillingSubscriptionInvoiceSectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("billing_profile_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (BillingSubscriptionInvoiceSectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := billingsubscription.ParseBillingAccountBillingSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Billing.BillingSubscriptionClient.Get(ctx, *id, billingsubscription.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (BillingSubscriptionInvoiceSectionResource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+data "azurerm_billing_invoice_section" "test" {
+  name                 = "%[3]s"
+  billing_account_name = "%[1]s"
+  billing_profile_name = "%[2]s"
+}
+
+resource "azurerm_billing_subscription_invoice_section" "test" {
+  billing_account_name = "%[1]s"
+  subscription_id      = data.azurerm_subscription.current.id
+  invoice_section_id   = data.azurerm_billing_invoice_section.test.id
+}
+`, os.Getenv("ARM_BILLING_ACCOUNT"), os.Getenv("ARM_BILLING_PROFILE"), os.Getenv("ARM_INVOICE_SECTION"))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingaccount"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingprofile"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/billingsubscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/billing/2024-04-01/invoicesection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	BillingAccountClient      *billingaccount.BillingAccountClient
+	BillingProfileClient      *billingprofile.BillingProfileClient
+	BillingSubscriptionClient *billingsubscription.BillingSubscriptionClient
+	InvoiceSectionClient      *invoicesection.InvoiceSectionClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	billingAccountClient, err := billingaccount.NewBillingAccountClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building BillingAccount client: %+v", err)
+	}
+	o.Configure(billingAccountClient.Client, o.Authorizers.ResourceManager)
+
+	billingProfileClient, err := billingprofile.NewBillingProfileClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building BillingProfile client: %+v", err)
+	}
+	o.Configure(billingProfileClient.Client, o.Authorizers.ResourceManager)
+
+	billingSubscriptionClient, err := billingsubscription.NewBillingSubscriptionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building BillingSubscription client: %+v", err)
+	}
+	o.Configure(billingSubscriptionClient.Client, o.Authorizers.ResourceManager)
+
+	invoiceSectionClient, err := invoicesection.NewInvoiceSectionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building InvoiceSection client: %+v", err)
+	}
+	o.Configure(invoiceSectionClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		BillingAccountClient:      billingAccountClient,
+		BillingProfileClient:      billingProfileClient,
+		BillingSubscriptionClient: billingSubscriptionClient,
+		InvoiceSectionClient:      invoiceSectionClient,
+	}, nil
+}
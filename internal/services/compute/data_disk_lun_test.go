@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+func TestNextAvailableDataDiskLun(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Disks    []virtualmachines.DataDisk
+		Expected int64
+	}{
+		{
+			Name:     "no data disks",
+			Disks:    []virtualmachines.DataDisk{},
+			Expected: 0,
+		},
+		{
+			Name: "contiguous luns",
+			Disks: []virtualmachines.DataDisk{
+				{Lun: 0},
+				{Lun: 1},
+				{Lun: 2},
+			},
+			Expected: 3,
+		},
+		{
+			Name: "gap in luns",
+			Disks: []virtualmachines.DataDisk{
+				{Lun: 0},
+				{Lun: 2},
+				{Lun: 3},
+			},
+			Expected: 1,
+		},
+		{
+			Name: "data disk being detached",
+			Disks: []virtualmachines.DataDisk{
+				{Lun: 0, ToBeDetached: pointer.To(true)},
+				{Lun: 1},
+			},
+			Expected: 0,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := nextAvailableDataDiskLun(usedDataDiskLuns(v.Disks))
+		if actual != v.Expected {
+			t.Fatalf("Expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
@@ -990,6 +990,26 @@ func TestAccLinuxVirtualMachine_otherRebootSetting(t *testing.T) {
 	})
 }
 
+func TestAccLinuxVirtualMachine_otherMoveResourceGroupWithDependentResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine", "test")
+	r := LinuxVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.otherMoveResourceGroup(data, "test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			// the Network Interface and OS Disk have to be moved alongside the Virtual Machine
+			Config:      r.otherMoveResourceGroup(data, "target"),
+			ExpectError: regexp.MustCompile("can't be moved to the Resource Group"),
+		},
+	})
+}
+
 func (r LinuxVirtualMachineResource) otherPatchMode(data acceptance.TestData, patchMode string) string {
 	return fmt.Sprintf(`
 %s
@@ -1979,6 +1999,50 @@ resource "azurerm_linux_virtual_machine" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r LinuxVirtualMachineResource) otherMoveResourceGroup(data acceptance.TestData, resourceGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      move_resources_on_resource_group_change = true
+    }
+  }
+}
+
+%[1]s
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[2]d"
+  resource_group_name             = azurerm_resource_group.%[4]s.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary, resourceGroup)
+}
+
 func (r LinuxVirtualMachineResource) otherLicenseType(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -67,7 +69,12 @@ func resourceManagedDisk() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			// NOTE: this is only ForceNew when moving resources between Resource Groups is disabled in the `features` block
+			"resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: resourcegroups.ValidateName,
+			},
 
 			"storage_account_type": {
 				Type:     pluginsdk.TypeString,
@@ -307,6 +314,9 @@ func resourceManagedDisk() *pluginsdk.Resource {
 
 		// Encryption Settings cannot be disabled once enabled
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("resource_group_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return !meta.(*clients.Client).Features.ResourceGroup.MoveResourcesOnResourceGroupChange
+			}),
 			pluginsdk.ForceNewIfChange("encryption_settings", func(ctx context.Context, old, new, meta interface{}) bool {
 				if !features.FourPointOhBeta() {
 					return false
@@ -629,6 +639,15 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("resource_group_name") {
+		newId := commonids.NewManagedDiskID(id.SubscriptionId, resourceGroup, id.DiskName)
+		if err := moveManagedDisk(ctx, meta.(*clients.Client), *id, newId); err != nil {
+			return err
+		}
+		id = &newId
+		d.SetId(id.ID())
+	}
+
 	disk, err := client.Get(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(disk.HttpResponse) {
@@ -1114,3 +1133,28 @@ func resourceManagedDiskDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// moveManagedDisk moves the Managed Disk into the Resource Group of `newId` - since Azure requires a Managed Disk which
+// is attached to a Virtual Machine to be moved alongside it, an error is returned if it's attached
+func moveManagedDisk(ctx context.Context, metaClient *clients.Client, id commonids.ManagedDiskId, newId commonids.ManagedDiskId) error {
+	client := metaClient.Compute.DisksClient
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return resourceClient.ResourceMovedOutsideOfTerraformError(id.ID(), newId.ID())
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dependentIds := make([]string, 0)
+	if model := existing.Model; model != nil && model.ManagedBy != nil && *model.ManagedBy != "" {
+		dependentIds = append(dependentIds, *model.ManagedBy)
+	}
+
+	if err := metaClient.Resource.MoveResourceToResourceGroup(ctx, id.ID(), dependentIds, newId.ResourceGroupName); err != nil {
+		return fmt.Errorf("moving %s to Resource Group %q: %+v", id, newId.ResourceGroupName, err)
+	}
+
+	return nil
+}
@@ -56,6 +56,29 @@ func TestAccManagedDisk_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_moveResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.moveResourceGroup(data, "test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.moveResourceGroup(data, "target"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_group_name").HasValue(fmt.Sprintf("acctestRG-target-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagedDisk_zeroGbFromPlatformImage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -859,6 +882,37 @@ resource "azurerm_managed_disk" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ManagedDiskResource) moveResourceGroup(data acceptance.TestData, resourceGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      move_resources_on_resource_group_change = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%[1]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.%[3]s.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "1"
+}
+`, data.RandomInteger, data.Locations.Primary, resourceGroup)
+}
+
 func (ManagedDiskResource) optimizedFrequentAttach(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VirtualMachineScaleSetRollingUpgradeId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	RollingUpgradeName         string
+}
+
+func NewVirtualMachineScaleSetRollingUpgradeID(subscriptionId, resourceGroup, virtualMachineScaleSetName, rollingUpgradeName string) VirtualMachineScaleSetRollingUpgradeId {
+	return VirtualMachineScaleSetRollingUpgradeId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		RollingUpgradeName:         rollingUpgradeName,
+	}
+}
+
+func (id VirtualMachineScaleSetRollingUpgradeId) String() string {
+	segments := []string{
+		fmt.Sprintf("Rolling Upgrade Name %q", id.RollingUpgradeName),
+		fmt.Sprintf("Virtual Machine Scale Set Name %q", id.VirtualMachineScaleSetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Scale Set Rolling Upgrade", segmentsStr)
+}
+
+func (id VirtualMachineScaleSetRollingUpgradeId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/rollingUpgrades/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.RollingUpgradeName)
+}
+
+// VirtualMachineScaleSetRollingUpgradeID parses a VirtualMachineScaleSetRollingUpgrade ID into an VirtualMachineScaleSetRollingUpgradeId struct
+func VirtualMachineScaleSetRollingUpgradeID(input string) (*VirtualMachineScaleSetRollingUpgradeId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an VirtualMachineScaleSetRollingUpgrade ID: %+v", input, err)
+	}
+
+	resourceId := VirtualMachineScaleSetRollingUpgradeId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+	if resourceId.RollingUpgradeName, err = id.PopSegment("rollingUpgrades"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -37,6 +38,19 @@ func dataSourceProximityPlacementGroup() *pluginsdk.Resource {
 
 			"location": commonschema.LocationComputed(),
 
+			"allowed_vm_sizes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -63,6 +77,19 @@ func dataSourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta inter
 
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
+
+		intentVmSizes := make([]string, 0)
+		if props := model.Properties; props != nil && props.Intent != nil && props.Intent.VMSizes != nil {
+			intentVmSizes = *props.Intent.VMSizes
+		}
+		d.Set("allowed_vm_sizes", intentVmSizes)
+
+		zone := ""
+		if v := zones.Flatten(model.Zones); len(v) != 0 {
+			zone = v[0]
+		}
+		d.Set("zone", zone)
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -30,6 +30,21 @@ func TestAccProximityPlacementGroupDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccProximityPlacementGroupDataSource_zone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_proximity_placement_group", "test")
+	r := ProximityPlacementGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.zone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("zone").HasValue("1"),
+				check.That(data.ResourceName).Key("allowed_vm_sizes.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (ProximityPlacementGroupDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -40,3 +55,14 @@ data "azurerm_proximity_placement_group" "test" {
 }
 `, ProximityPlacementGroupResource{}.withTags(data))
 }
+
+func (ProximityPlacementGroupDataSource) zone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_proximity_placement_group" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  name                = azurerm_proximity_placement_group.test.name
+}
+`, ProximityPlacementGroupResource{}.zone(data))
+}
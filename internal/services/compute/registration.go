@@ -83,6 +83,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		OrchestratedVirtualMachineScaleSetDataSource{},
+		VirtualMachinePlacementGuidanceDataSource{},
+		VirtualMachineRunCommandDataSource{},
 	}
 }
 
@@ -96,5 +98,7 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineRestorePointCollectionResource{},
 		VirtualMachineRestorePointResource{},
 		VirtualMachineGalleryApplicationAssignmentResource{},
+		VirtualMachineDataDisksResource{},
+		VirtualMachineScaleSetRollingUpgradeResource{},
 	}
 }
@@ -13,8 +13,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplicationversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkinterfaces"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -630,3 +633,66 @@ func flattenVirtualMachineGalleryApplication(input *[]virtualmachines.VMGalleryA
 
 	return out
 }
+
+// moveVirtualMachine moves the Virtual Machine into the Resource Group of `newId` - since Azure requires the Network
+// Interfaces, Public IPs and Managed Disks attached to it to be moved in the same request, an error is returned when any
+// of these aren't already within the target Resource Group
+func moveVirtualMachine(ctx context.Context, metaClient *clients.Client, id virtualmachines.VirtualMachineId, newId virtualmachines.VirtualMachineId) error {
+	client := metaClient.Compute.VirtualMachinesClient
+
+	existing, err := client.Get(ctx, id, virtualmachines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return resourceClient.ResourceMovedOutsideOfTerraformError(id.ID(), newId.ID())
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dependentIds := make([]string, 0)
+	if model := existing.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		if props.NetworkProfile != nil && props.NetworkProfile.NetworkInterfaces != nil {
+			for _, nic := range *props.NetworkProfile.NetworkInterfaces {
+				if nic.Id == nil {
+					continue
+				}
+				dependentIds = append(dependentIds, *nic.Id)
+
+				nicId, err := commonids.ParseNetworkInterfaceIDInsensitively(*nic.Id)
+				if err != nil {
+					return err
+				}
+				nicResp, err := metaClient.Network.NetworkInterfacesClient.Get(ctx, *nicId, networkinterfaces.DefaultGetOperationOptions())
+				if err != nil {
+					return fmt.Errorf("retrieving %s attached to %s: %+v", *nicId, id, err)
+				}
+				if nicModel := nicResp.Model; nicModel != nil && nicModel.Properties != nil && nicModel.Properties.IPConfigurations != nil {
+					for _, ipConfig := range *nicModel.Properties.IPConfigurations {
+						if ipConfig.Properties != nil && ipConfig.Properties.PublicIPAddress != nil && ipConfig.Properties.PublicIPAddress.Id != nil {
+							dependentIds = append(dependentIds, *ipConfig.Properties.PublicIPAddress.Id)
+						}
+					}
+				}
+			}
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if profile.OsDisk != nil && profile.OsDisk.ManagedDisk != nil && profile.OsDisk.ManagedDisk.Id != nil {
+				dependentIds = append(dependentIds, *profile.OsDisk.ManagedDisk.Id)
+			}
+			if profile.DataDisks != nil {
+				for _, disk := range *profile.DataDisks {
+					if disk.ManagedDisk != nil && disk.ManagedDisk.Id != nil {
+						dependentIds = append(dependentIds, *disk.ManagedDisk.Id)
+					}
+				}
+			}
+		}
+	}
+
+	if err := metaClient.Resource.MoveResourceToResourceGroup(ctx, id.ID(), dependentIds, newId.ResourceGroupName); err != nil {
+		return fmt.Errorf("moving %s to Resource Group %q: %+v", id, newId.ResourceGroupName, err)
+	}
+
+	return nil
+}
@@ -102,7 +102,8 @@ func resourceVirtualMachineDataDiskAttachment() *pluginsdk.Resource {
 
 			"lun": {
 				Type:         pluginsdk.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
@@ -133,6 +134,12 @@ func resourceVirtualMachineDataDiskAttachment() *pluginsdk.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"force_detach_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -178,9 +185,21 @@ func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *pluginsdk.ResourceD
 		return fmt.Errorf("unable to determine Storage Account Type for Managed Disk %q: %+v", managedDiskId, err)
 	}
 
+	// there are ways to provision a VM without a StorageProfile and/or DataDisks
+	if virtualMachine.Model.Properties.StorageProfile.DataDisks == nil {
+		virtualMachine.Model.Properties.StorageProfile.DataDisks = pointer.To(make([]virtualmachines.DataDisk, 0))
+	}
+
+	disks := *virtualMachine.Model.Properties.StorageProfile.DataDisks
+
 	name := *managedDisk.Name
 	resourceId := fmt.Sprintf("%s/dataDisks/%s", parsedVirtualMachineId.ID(), name)
 	lun := int32(d.Get("lun").(int))
+	if d.IsNewResource() && d.GetRawConfig().AsValueMap()["lun"].IsNull() {
+		// the lowest LUN which isn't in use is assigned when one isn't specified - which is safe since attachments
+		// to the Virtual Machine are serialised by the lock above
+		lun = int32(nextAvailableDataDiskLun(usedDataDiskLuns(disks)))
+	}
 	caching := d.Get("caching").(string)
 	createOption := virtualmachines.DiskCreateOptionTypes(d.Get("create_option").(string))
 	writeAcceleratorEnabled := d.Get("write_accelerator_enabled").(bool)
@@ -197,17 +216,6 @@ func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *pluginsdk.ResourceD
 		WriteAcceleratorEnabled: pointer.To(writeAcceleratorEnabled),
 	}
 
-	// there are ways to provision a VM without a StorageProfile and/or DataDisks
-	if virtualMachine.Model.Properties.StorageProfile == nil {
-		virtualMachine.Model.Properties.StorageProfile = &virtualmachines.StorageProfile{}
-	}
-
-	if virtualMachine.Model.Properties.StorageProfile.DataDisks == nil {
-		virtualMachine.Model.Properties.StorageProfile.DataDisks = pointer.To(make([]virtualmachines.DataDisk, 0))
-	}
-
-	disks := *virtualMachine.Model.Properties.StorageProfile.DataDisks
-
 	existingIndex := -1
 	for i, disk := range disks {
 		if *disk.Name == name {
@@ -300,6 +308,8 @@ func resourceVirtualMachineDataDiskAttachmentRead(d *pluginsdk.ResourceData, met
 	d.Set("caching", string(pointer.From(disk.Caching)))
 	d.Set("create_option", string(disk.CreateOption))
 	d.Set("write_accelerator_enabled", disk.WriteAcceleratorEnabled)
+	// this isn't returned by the API, so is retained from the state
+	d.Set("force_detach_enabled", d.Get("force_detach_enabled").(bool))
 
 	if managedDisk := disk.ManagedDisk; managedDisk != nil {
 		d.Set("managed_disk_id", managedDisk.Id)
@@ -344,12 +354,18 @@ func resourceVirtualMachineDataDiskAttachmentDelete(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("retrieving %s: `storageprofile` was nil", virtualMachineId)
 	}
 
+	// the Data Disk is marked to be detached rather than being removed, which allows it to be hot-detached
+	// without stopping the Virtual Machine where that's supported
 	dataDisks := make([]virtualmachines.DataDisk, 0)
-	for _, dataDisk := range *virtualMachine.Model.Properties.StorageProfile.DataDisks {
+	for _, dataDisk := range pointer.From(virtualMachine.Model.Properties.StorageProfile.DataDisks) {
 		// since this field isn't (and shouldn't be) case-sensitive; we're deliberately not using `strings.EqualFold`
-		if *dataDisk.Name != id.Name {
-			dataDisks = append(dataDisks, dataDisk)
+		if *dataDisk.Name == id.Name {
+			dataDisk.ToBeDetached = pointer.To(true)
+			if d.Get("force_detach_enabled").(bool) {
+				dataDisk.DetachOption = pointer.To(virtualmachines.DiskDetachOptionTypesForceDetach)
+			}
 		}
+		dataDisks = append(dataDisks, dataDisk)
 	}
 
 	virtualMachine.Model.Properties.StorageProfile.DataDisks = &dataDisks
@@ -389,3 +405,25 @@ func retrieveDataDiskAttachmentManagedDisk(d *pluginsdk.ResourceData, meta inter
 
 	return resp.Model, nil
 }
+
+// usedDataDiskLuns returns the LUNs in use by the Data Disks attached to a Virtual Machine
+func usedDataDiskLuns(input []virtualmachines.DataDisk) map[int64]bool {
+	output := make(map[int64]bool)
+	for _, disk := range input {
+		if !pointer.From(disk.ToBeDetached) {
+			output[disk.Lun] = true
+		}
+	}
+
+	return output
+}
+
+// nextAvailableDataDiskLun returns the lowest LUN which isn't in use
+func nextAvailableDataDiskLun(used map[int64]bool) int64 {
+	lun := int64(0)
+	for used[lun] {
+		lun++
+	}
+
+	return lun
+}
@@ -101,6 +101,41 @@ func TestAccVirtualMachineDataDiskAttachment_multipleDisks(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineDataDiskAttachment_autoLun(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_data_disk_attachment", "first")
+	r := VirtualMachineDataDiskAttachmentResource{}
+
+	secondResourceName := "azurerm_virtual_machine_data_disk_attachment.second"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoLun(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lun").HasValue("0"),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).Key("lun").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineDataDiskAttachment_forceDetach(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_data_disk_attachment", "test")
+	r := VirtualMachineDataDiskAttachmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forceDetach(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_detach_enabled"),
+	})
+}
+
 func TestAccVirtualMachineDataDiskAttachment_updatingCaching(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_data_disk_attachment", "test")
 	r := VirtualMachineDataDiskAttachmentResource{}
@@ -449,6 +484,49 @@ resource "azurerm_virtual_machine_data_disk_attachment" "second" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r VirtualMachineDataDiskAttachmentResource) autoLun(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_data_disk_attachment" "first" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  caching            = "None"
+}
+
+resource "azurerm_managed_disk" "second" {
+  name                 = "%d-disk2"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "second" {
+  managed_disk_id    = azurerm_managed_disk.second.id
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  caching            = "ReadOnly"
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.first]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualMachineDataDiskAttachmentResource) forceDetach(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id      = azurerm_managed_disk.test.id
+  virtual_machine_id   = azurerm_virtual_machine.test.id
+  lun                  = "0"
+  caching              = "None"
+  force_detach_enabled = true
+}
+`, r.template(data))
+}
+
 func (r VirtualMachineDataDiskAttachmentResource) readOnly(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
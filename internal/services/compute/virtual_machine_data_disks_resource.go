@@ -0,0 +1,490 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineDataDisksResource struct{}
+
+type VirtualMachineDataDisksModel struct {
+	VirtualMachineId   string                   `tfschema:"virtual_machine_id"`
+	DataDisk           []VirtualMachineDataDisk `tfschema:"data_disk"`
+	ForceDetachEnabled bool                     `tfschema:"force_detach_enabled"`
+}
+
+type VirtualMachineDataDisk struct {
+	ManagedDiskId           string `tfschema:"managed_disk_id"`
+	Lun                     int64  `tfschema:"lun"`
+	Caching                 string `tfschema:"caching"`
+	CreateOption            string `tfschema:"create_option"`
+	WriteAcceleratorEnabled bool   `tfschema:"write_accelerator_enabled"`
+}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineDataDisksResource{}
+
+func (r VirtualMachineDataDisksResource) ModelObject() interface{} {
+	return &VirtualMachineDataDisksModel{}
+}
+
+func (r VirtualMachineDataDisksResource) ResourceType() string {
+	return "azurerm_virtual_machine_data_disks"
+}
+
+func (r VirtualMachineDataDisksResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateVirtualMachineID
+}
+
+func (r VirtualMachineDataDisksResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"data_disk": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 64,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"managed_disk_id": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						DiffSuppressFunc: suppress.CaseDifference,
+						ValidateFunc:     commonids.ValidateManagedDiskID,
+					},
+
+					"caching": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachines.CachingTypesNone),
+							string(virtualmachines.CachingTypesReadOnly),
+							string(virtualmachines.CachingTypesReadWrite),
+						}, false),
+					},
+
+					"lun": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"create_option": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(virtualmachines.DiskCreateOptionTypesAttach),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachines.DiskCreateOptionTypesAttach),
+							string(virtualmachines.DiskCreateOptionTypesEmpty),
+						}, false),
+					},
+
+					"write_accelerator_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"force_detach_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r VirtualMachineDataDisksResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineDataDisksResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			var config VirtualMachineDataDisksModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := virtualmachines.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+			virtualMachine, err := retrieveVirtualMachineForDataDisks(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			existing := pointer.From(virtualMachine.Properties.StorageProfile.DataDisks)
+			for _, disk := range config.DataDisk {
+				if findDataDiskByManagedDiskId(existing, disk.ManagedDiskId) != -1 {
+					return fmt.Errorf("the Managed Disk %q is already attached to %s - to be managed via Terraform this Data Disk needs to be removed from the Virtual Machine or this resource needs to be imported", disk.ManagedDiskId, *id)
+				}
+			}
+
+			dataDisks, err := expandVirtualMachineDataDisks(ctx, metadata, config.DataDisk, existing)
+			if err != nil {
+				return err
+			}
+			virtualMachine.Properties.StorageProfile.DataDisks = &dataDisks
+
+			// all the Data Disks are attached with a single update, rather than one update per Data Disk
+			if err := updateVirtualMachineDataDisks(ctx, client, *id, *virtualMachine); err != nil {
+				return fmt.Errorf("attaching Data Disks to %s: %+v", *id, err)
+			}
+
+			metadata.SetID(*id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineDataDisksResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := virtualmachines.ParseVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var existing VirtualMachineDataDisksModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := VirtualMachineDataDisksModel{
+				VirtualMachineId:   id.ID(),
+				DataDisk:           make([]VirtualMachineDataDisk, 0),
+				ForceDetachEnabled: existing.ForceDetachEnabled,
+			}
+
+			dataDisks := make([]virtualmachines.DataDisk, 0)
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.StorageProfile != nil {
+				dataDisks = pointer.From(model.Properties.StorageProfile.DataDisks)
+			}
+
+			if len(existing.DataDisk) == 0 {
+				// when importing, all the Data Disks which can be managed by this resource are included
+				for _, disk := range dataDisks {
+					if disk.ManagedDisk == nil || (disk.CreateOption != virtualmachines.DiskCreateOptionTypesAttach && disk.CreateOption != virtualmachines.DiskCreateOptionTypesEmpty) {
+						continue
+					}
+					state.DataDisk = append(state.DataDisk, flattenVirtualMachineDataDisk(disk))
+				}
+			} else {
+				// otherwise only the Data Disks managed by this resource are tracked, in the order they're defined
+				for _, v := range existing.DataDisk {
+					if index := findDataDiskByManagedDiskId(dataDisks, v.ManagedDiskId); index != -1 {
+						state.DataDisk = append(state.DataDisk, flattenVirtualMachineDataDisk(dataDisks[index]))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineDataDisksResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := virtualmachines.ParseVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineDataDisksModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChange("data_disk") {
+				return nil
+			}
+
+			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+			virtualMachine, err := retrieveVirtualMachineForDataDisks(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			existing := pointer.From(virtualMachine.Properties.StorageProfile.DataDisks)
+
+			// Data Disks which are no longer defined are detached, any others attached to the Virtual Machine are left as-is
+			old, _ := metadata.ResourceData.GetChange("data_disk")
+			for _, raw := range old.([]interface{}) {
+				v, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				managedDiskId := v["managed_disk_id"].(string)
+				if findVirtualMachineDataDiskByManagedDiskId(config.DataDisk, managedDiskId) != -1 {
+					continue
+				}
+				if index := findDataDiskByManagedDiskId(existing, managedDiskId); index != -1 {
+					detachDataDisk(&existing[index], config.ForceDetachEnabled)
+				}
+			}
+
+			toAttach := make([]VirtualMachineDataDisk, 0)
+			for _, disk := range config.DataDisk {
+				index := findDataDiskByManagedDiskId(existing, disk.ManagedDiskId)
+				if index == -1 {
+					toAttach = append(toAttach, disk)
+					continue
+				}
+
+				if lunIsConfigured(metadata, disk.ManagedDiskId) && disk.Lun != existing[index].Lun {
+					return fmt.Errorf("the `lun` of the Data Disk %q can't be changed whilst it's attached - remove the Data Disk and add it again to change this", disk.ManagedDiskId)
+				}
+
+				existing[index].Caching = pointer.To(virtualmachines.CachingTypes(disk.Caching))
+				existing[index].WriteAcceleratorEnabled = pointer.To(disk.WriteAcceleratorEnabled)
+			}
+
+			dataDisks, err := expandVirtualMachineDataDisks(ctx, metadata, toAttach, existing)
+			if err != nil {
+				return err
+			}
+			virtualMachine.Properties.StorageProfile.DataDisks = &dataDisks
+
+			if err := updateVirtualMachineDataDisks(ctx, client, *id, *virtualMachine); err != nil {
+				return fmt.Errorf("updating Data Disks for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineDataDisksResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := virtualmachines.ParseVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineDataDisksModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+			virtualMachine, err := retrieveVirtualMachineForDataDisks(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			dataDisks := pointer.From(virtualMachine.Properties.StorageProfile.DataDisks)
+			for _, disk := range config.DataDisk {
+				if index := findDataDiskByManagedDiskId(dataDisks, disk.ManagedDiskId); index != -1 {
+					detachDataDisk(&dataDisks[index], config.ForceDetachEnabled)
+				}
+			}
+			virtualMachine.Properties.StorageProfile.DataDisks = &dataDisks
+
+			if err := updateVirtualMachineDataDisks(ctx, client, *id, *virtualMachine); err != nil {
+				return fmt.Errorf("detaching Data Disks from %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func retrieveVirtualMachineForDataDisks(ctx context.Context, client *virtualmachines.VirtualMachinesClient, id virtualmachines.VirtualMachineId) (*virtualmachines.VirtualMachine, error) {
+	resp, err := client.Get(ctx, id, virtualmachines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s was not found", id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	if resp.Model.Properties.StorageProfile == nil {
+		return nil, fmt.Errorf("retrieving %s: `storageprofile` was nil", id)
+	}
+
+	return resp.Model, nil
+}
+
+func updateVirtualMachineDataDisks(ctx context.Context, client *virtualmachines.VirtualMachinesClient, id virtualmachines.VirtualMachineId, virtualMachine virtualmachines.VirtualMachine) error {
+	// fixes #2485
+	virtualMachine.Identity = nil
+	// fixes #1600
+	virtualMachine.Resources = nil
+	// fixes #24145
+	virtualMachine.Properties.ApplicationProfile = nil
+
+	return client.CreateOrUpdateThenPoll(ctx, id, virtualMachine, virtualmachines.DefaultCreateOrUpdateOperationOptions())
+}
+
+// expandVirtualMachineDataDisks appends the Data Disks to attach to the existing Data Disks, assigning a LUN to those without one
+func expandVirtualMachineDataDisks(ctx context.Context, metadata sdk.ResourceMetaData, input []VirtualMachineDataDisk, existing []virtualmachines.DataDisk) ([]virtualmachines.DataDisk, error) {
+	disksClient := metadata.Client.Compute.DisksClient
+
+	usedLuns := usedDataDiskLuns(existing)
+	configuredLuns := make(map[string]bool)
+	for _, disk := range input {
+		if lunIsConfigured(metadata, disk.ManagedDiskId) {
+			usedLuns[disk.Lun] = true
+			configuredLuns[strings.ToLower(disk.ManagedDiskId)] = true
+		}
+	}
+
+	output := existing
+	for _, disk := range input {
+		managedDiskId, err := commonids.ParseManagedDiskID(disk.ManagedDiskId)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := disksClient.Get(ctx, *managedDiskId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *managedDiskId, err)
+		}
+		if resp.Model == nil || resp.Model.Sku == nil || resp.Model.Sku.Name == nil {
+			return nil, fmt.Errorf("unable to determine Storage Account Type for %s", *managedDiskId)
+		}
+
+		lun := disk.Lun
+		if !configuredLuns[strings.ToLower(disk.ManagedDiskId)] {
+			lun = nextAvailableDataDiskLun(usedLuns)
+			usedLuns[lun] = true
+		}
+
+		output = append(output, virtualmachines.DataDisk{
+			Name:         pointer.To(managedDiskId.DiskName),
+			Caching:      pointer.To(virtualmachines.CachingTypes(disk.Caching)),
+			CreateOption: virtualmachines.DiskCreateOptionTypes(disk.CreateOption),
+			Lun:          lun,
+			ManagedDisk: &virtualmachines.ManagedDiskParameters{
+				Id:                 pointer.To(managedDiskId.ID()),
+				StorageAccountType: pointer.To(virtualmachines.StorageAccountTypes(*resp.Model.Sku.Name)),
+			},
+			WriteAcceleratorEnabled: pointer.To(disk.WriteAcceleratorEnabled),
+		})
+	}
+
+	return output, nil
+}
+
+func flattenVirtualMachineDataDisk(input virtualmachines.DataDisk) VirtualMachineDataDisk {
+	output := VirtualMachineDataDisk{
+		Caching:                 string(pointer.From(input.Caching)),
+		CreateOption:            string(input.CreateOption),
+		Lun:                     input.Lun,
+		WriteAcceleratorEnabled: pointer.From(input.WriteAcceleratorEnabled),
+	}
+
+	if managedDisk := input.ManagedDisk; managedDisk != nil {
+		output.ManagedDiskId = pointer.From(managedDisk.Id)
+	}
+
+	return output
+}
+
+func detachDataDisk(input *virtualmachines.DataDisk, forceDetach bool) {
+	// marking the Data Disk to be detached allows it to be hot-detached without stopping the Virtual Machine where that's supported
+	input.ToBeDetached = pointer.To(true)
+	if forceDetach {
+		input.DetachOption = pointer.To(virtualmachines.DiskDetachOptionTypesForceDetach)
+	}
+}
+
+// lunIsConfigured returns whether the `lun` of the Data Disk with the specified Managed Disk ID is set in the configuration
+func lunIsConfigured(metadata sdk.ResourceMetaData, managedDiskId string) bool {
+	raw := metadata.ResourceData.GetRawConfig().AsValueMap()["data_disk"]
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+
+	for _, v := range raw.AsValueSlice() {
+		values := v.AsValueMap()
+		id := values["managed_disk_id"]
+		if id.IsNull() || !id.IsKnown() || !strings.EqualFold(id.AsString(), managedDiskId) {
+			continue
+		}
+		return !values["lun"].IsNull()
+	}
+
+	return false
+}
+
+func findDataDiskByManagedDiskId(input []virtualmachines.DataDisk, managedDiskId string) int {
+	for i, disk := range input {
+		if disk.ManagedDisk != nil && strings.EqualFold(pointer.From(disk.ManagedDisk.Id), managedDiskId) && !pointer.From(disk.ToBeDetached) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func findVirtualMachineDataDiskByManagedDiskId(input []VirtualMachineDataDisk, managedDiskId string) int {
+	for i, disk := range input {
+		if strings.EqualFold(disk.ManagedDiskId, managedDiskId) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineDataDisksResource struct{}
+
+func TestAccVirtualMachineDataDisks_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_data_disks", "test")
+	r := VirtualMachineDataDisksResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("3"),
+				check.That(data.ResourceName).Key("data_disk.0.lun").HasValue("0"),
+				check.That(data.ResourceName).Key("data_disk.1.lun").HasValue("1"),
+				check.That(data.ResourceName).Key("data_disk.2.lun").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineDataDisks_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_data_disks", "test")
+	r := VirtualMachineDataDisksResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("2"),
+				check.That(data.ResourceName).Key("data_disk.0.caching").HasValue("ReadOnly"),
+				check.That(data.ResourceName).Key("data_disk.1.lun").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualMachineDataDisksResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualmachines.ParseVirtualMachineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VirtualMachinesClient.Get(ctx, *id, virtualmachines.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	managedDiskIds := make(map[string]bool)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.StorageProfile != nil {
+		for _, disk := range pointer.From(model.Properties.StorageProfile.DataDisks) {
+			if disk.ManagedDisk != nil {
+				managedDiskIds[strings.ToLower(pointer.From(disk.ManagedDisk.Id))] = true
+			}
+		}
+	}
+
+	for k, v := range state.Attributes {
+		if strings.HasPrefix(k, "data_disk.") && strings.HasSuffix(k, ".managed_disk_id") && !managedDiskIds[strings.ToLower(v)] {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r VirtualMachineDataDisksResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_data_disks" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test[0].id
+    caching         = "None"
+  }
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test[1].id
+    caching         = "None"
+  }
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test[2].id
+    caching         = "ReadWrite"
+  }
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineDataDisksResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_data_disks" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test[0].id
+    caching         = "ReadOnly"
+  }
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test[3].id
+    lun             = 10
+    caching         = "None"
+  }
+}
+`, r.template(data))
+}
+
+func (VirtualMachineDataDisksResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctvm-%[1]d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  network_interface_ids           = [azurerm_network_interface.test.id]
+  size                            = "Standard_F4"
+  admin_username                  = "testadmin"
+  admin_password                  = "Password1234!"
+  disable_password_authentication = false
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  count                = 4
+  name                 = "acctestdisk-%[1]d-${count.index}"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
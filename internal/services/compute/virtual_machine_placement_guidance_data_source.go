@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachinePlacementGuidanceDataSource struct{}
+
+type VirtualMachinePlacementGuidanceDataSourceModel struct {
+	Location   string                                 `tfschema:"location"`
+	VMSizes    []string                               `tfschema:"vm_sizes"`
+	Zones      []string                               `tfschema:"zones"`
+	Sizes      []VirtualMachinePlacementGuidanceSize  `tfschema:"sizes"`
+	Placements []VirtualMachinePlacementGuidanceZonal `tfschema:"placements"`
+}
+
+type VirtualMachinePlacementGuidanceSize struct {
+	Name              string   `tfschema:"name"`
+	Family            string   `tfschema:"family"`
+	Available         bool     `tfschema:"available"`
+	Zones             []string `tfschema:"zones"`
+	DedicatedHostSkus []string `tfschema:"dedicated_host_skus"`
+}
+
+type VirtualMachinePlacementGuidanceZonal struct {
+	Zone              string   `tfschema:"zone"`
+	DedicatedHostSkus []string `tfschema:"dedicated_host_skus"`
+}
+
+var _ sdk.DataSource = VirtualMachinePlacementGuidanceDataSource{}
+
+func (d VirtualMachinePlacementGuidanceDataSource) ModelObject() interface{} {
+	return &VirtualMachinePlacementGuidanceDataSourceModel{}
+}
+
+func (d VirtualMachinePlacementGuidanceDataSource) ResourceType() string {
+	return "azurerm_virtual_machine_placement_guidance"
+}
+
+func (d VirtualMachinePlacementGuidanceDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationWithoutForceNew(),
+
+		"vm_sizes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (d VirtualMachinePlacementGuidanceDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"zones": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"sizes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"zones": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"dedicated_host_skus": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"placements": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"zone": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"dedicated_host_skus": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d VirtualMachinePlacementGuidanceDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.SkusClient
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var config VirtualMachinePlacementGuidanceDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locationName := location.Normalize(config.Location)
+
+			opts := skus.DefaultResourceSkusListOperationOptions()
+			// by default this API returns every SKU in every location, so this is filtered to the requested location
+			opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", locationName))
+			resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, opts)
+			if err != nil {
+				return fmt.Errorf("listing the Resource SKUs for the location %q: %+v", locationName, err)
+			}
+
+			vmSkus := make(map[string]skus.ResourceSku)
+			hostSkus := make([]skus.ResourceSku, 0)
+			for _, item := range resp.Items {
+				switch strings.ToLower(pointer.From(item.ResourceType)) {
+				case "virtualmachines":
+					vmSkus[strings.ToLower(pointer.From(item.Name))] = item
+				case "hostgroups/hosts":
+					hostSkus = append(hostSkus, item)
+				}
+			}
+
+			state := VirtualMachinePlacementGuidanceDataSourceModel{
+				Location:   locationName,
+				VMSizes:    config.VMSizes,
+				Sizes:      make([]VirtualMachinePlacementGuidanceSize, 0),
+				Placements: make([]VirtualMachinePlacementGuidanceZonal, 0),
+			}
+
+			// the zones in which every Virtual Machine size is available, which is where a Proximity Placement Group
+			// with these sizes as its `allowed_vm_sizes` can be placed
+			var commonZones map[string]bool
+			hostSkusByZone := make(map[string]map[string]bool)
+
+			for _, vmSize := range config.VMSizes {
+				size := VirtualMachinePlacementGuidanceSize{
+					Name:              vmSize,
+					Zones:             make([]string, 0),
+					DedicatedHostSkus: make([]string, 0),
+				}
+
+				zones := make(map[string]bool)
+				if sku, ok := vmSkus[strings.ToLower(vmSize)]; ok {
+					size.Name = pointer.From(sku.Name)
+					size.Family = pointer.From(sku.Family)
+					size.Available, zones = availableResourceSkuZones(sku, locationName)
+
+					for _, hostSku := range hostSkus {
+						if !dedicatedHostSkuSupportsFamily(pointer.From(hostSku.Name), size.Family) {
+							continue
+						}
+
+						available, hostZones := availableResourceSkuZones(hostSku, locationName)
+						if !available {
+							continue
+						}
+
+						size.DedicatedHostSkus = append(size.DedicatedHostSkus, pointer.From(hostSku.Name))
+						for zone := range hostZones {
+							if hostSkusByZone[zone] == nil {
+								hostSkusByZone[zone] = make(map[string]bool)
+							}
+							hostSkusByZone[zone][pointer.From(hostSku.Name)] = true
+						}
+					}
+				}
+
+				for zone := range zones {
+					size.Zones = append(size.Zones, zone)
+				}
+				sort.Strings(size.Zones)
+				sort.Strings(size.DedicatedHostSkus)
+
+				if commonZones == nil {
+					commonZones = zones
+				} else {
+					for zone := range commonZones {
+						if !zones[zone] {
+							delete(commonZones, zone)
+						}
+					}
+				}
+
+				state.Sizes = append(state.Sizes, size)
+			}
+
+			state.Zones = make([]string, 0)
+			for zone := range commonZones {
+				state.Zones = append(state.Zones, zone)
+			}
+			sort.Strings(state.Zones)
+
+			for _, zone := range state.Zones {
+				placement := VirtualMachinePlacementGuidanceZonal{
+					Zone:              zone,
+					DedicatedHostSkus: make([]string, 0),
+				}
+				for hostSku := range hostSkusByZone[zone] {
+					placement.DedicatedHostSkus = append(placement.DedicatedHostSkus, hostSku)
+				}
+				sort.Strings(placement.DedicatedHostSkus)
+
+				state.Placements = append(state.Placements, placement)
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/locations/%s/vmSizes/%s", subscriptionId.ID(), locationName, strings.Join(config.VMSizes, ",")))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// availableResourceSkuZones returns whether the SKU is available to the Subscription in the location, and the zones in which it's available
+func availableResourceSkuZones(input skus.ResourceSku, locationName string) (bool, map[string]bool) {
+	zones := make(map[string]bool)
+
+	available := false
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if location.Normalize(pointer.From(info.Location)) != locationName {
+				continue
+			}
+
+			available = true
+			if info.Zones != nil {
+				for _, zone := range *info.Zones {
+					zones[zone] = true
+				}
+			}
+		}
+	}
+
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			switch pointer.From(restriction.Type) {
+			case skus.ResourceSkuRestrictionsTypeLocation:
+				return false, map[string]bool{}
+			case skus.ResourceSkuRestrictionsTypeZone:
+				if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+					for _, zone := range *info.Zones {
+						delete(zones, zone)
+					}
+				}
+			}
+		}
+	}
+
+	return available, zones
+}
+
+// dedicatedHostSkuSupportsFamily returns whether a Dedicated Host SKU (e.g. `DSv3-Type1`) can host Virtual Machines of the
+// family (e.g. `standardDSv3Family`), since Dedicated Host SKUs are named after the series of Virtual Machine they can host
+func dedicatedHostSkuSupportsFamily(hostSku string, family string) bool {
+	series, _, ok := strings.Cut(hostSku, "-")
+	if !ok || family == "" {
+		return false
+	}
+
+	family = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(family), "standard"), "family")
+	return strings.EqualFold(series, family)
+}
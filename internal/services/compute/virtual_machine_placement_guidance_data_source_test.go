@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachinePlacementGuidanceDataSource struct{}
+
+func TestAccVirtualMachinePlacementGuidanceDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_placement_guidance", "test")
+	r := VirtualMachinePlacementGuidanceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("sizes.0.name").HasValue("Standard_D2s_v3"),
+				check.That(data.ResourceName).Key("sizes.0.family").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.available").HasValue("true"),
+				check.That(data.ResourceName).Key("zones.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachinePlacementGuidanceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_placement_guidance" "test" {
+  location = "%s"
+  vm_sizes = ["Standard_D2s_v3", "Standard_E2s_v3"]
+}
+`, data.Locations.Primary)
+}
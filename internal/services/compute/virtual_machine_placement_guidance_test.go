@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestAvailableResourceSkuZones(t *testing.T) {
+	testData := []struct {
+		Name              string
+		Sku               skus.ResourceSku
+		ExpectedAvailable bool
+		ExpectedZones     map[string]bool
+	}{
+		{
+			Name:              "not offered in the location",
+			Sku:               skus.ResourceSku{},
+			ExpectedAvailable: false,
+			ExpectedZones:     map[string]bool{},
+		},
+		{
+			Name: "regional only",
+			Sku: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{Location: pointer.To("WestEurope")},
+				},
+			},
+			ExpectedAvailable: true,
+			ExpectedZones:     map[string]bool{},
+		},
+		{
+			Name: "zonal",
+			Sku: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{Location: pointer.To("westeurope"), Zones: &zones.Schema{"1", "2", "3"}},
+				},
+			},
+			ExpectedAvailable: true,
+			ExpectedZones:     map[string]bool{"1": true, "2": true, "3": true},
+		},
+		{
+			Name: "restricted in a zone",
+			Sku: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{Location: pointer.To("westeurope"), Zones: &zones.Schema{"1", "2", "3"}},
+				},
+				Restrictions: &[]skus.ResourceSkuRestrictions{
+					{
+						Type:            pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+						RestrictionInfo: &skus.ResourceSkuRestrictionInfo{Zones: &zones.Schema{"2"}},
+					},
+				},
+			},
+			ExpectedAvailable: true,
+			ExpectedZones:     map[string]bool{"1": true, "3": true},
+		},
+		{
+			Name: "restricted in the location",
+			Sku: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{Location: pointer.To("westeurope"), Zones: &zones.Schema{"1", "2", "3"}},
+				},
+				Restrictions: &[]skus.ResourceSkuRestrictions{
+					{Type: pointer.To(skus.ResourceSkuRestrictionsTypeLocation)},
+				},
+			},
+			ExpectedAvailable: false,
+			ExpectedZones:     map[string]bool{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		available, zones := availableResourceSkuZones(v.Sku, "westeurope")
+		if available != v.ExpectedAvailable {
+			t.Fatalf("expected available to be %t but got %t", v.ExpectedAvailable, available)
+		}
+		if !reflect.DeepEqual(zones, v.ExpectedZones) {
+			t.Fatalf("expected zones to be %+v but got %+v", v.ExpectedZones, zones)
+		}
+	}
+}
+
+func TestDedicatedHostSkuSupportsFamily(t *testing.T) {
+	testData := []struct {
+		HostSku  string
+		Family   string
+		Expected bool
+	}{
+		{HostSku: "DSv3-Type1", Family: "standardDSv3Family", Expected: true},
+		{HostSku: "DSv3-Type4", Family: "standardDSv3Family", Expected: true},
+		{HostSku: "ESv3-Type1", Family: "standardDSv3Family", Expected: false},
+		{HostSku: "DSv3", Family: "standardDSv3Family", Expected: false},
+		{HostSku: "DSv3-Type1", Family: "", Expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q..", v.HostSku, v.Family)
+
+		if actual := dedicatedHostSkuSupportsFamily(v.HostSku, v.Family); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineRunCommandDataSource struct{}
+
+type VirtualMachineRunCommandDataSourceModel struct {
+	VirtualMachineId string                                         `tfschema:"virtual_machine_id"`
+	CommandId        string                                         `tfschema:"command_id"`
+	Script           string                                         `tfschema:"script"`
+	Parameter        []VirtualMachineRunCommandInputParameterSchema `tfschema:"parameter"`
+	Stdout           string                                         `tfschema:"stdout"`
+	Stderr           string                                         `tfschema:"stderr"`
+}
+
+var _ sdk.DataSource = VirtualMachineRunCommandDataSource{}
+
+func (d VirtualMachineRunCommandDataSource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandDataSourceModel{}
+}
+
+func (d VirtualMachineRunCommandDataSource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (d VirtualMachineRunCommandDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"script": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"command_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "RunShellScript",
+			ValidateFunc: validation.StringInSlice([]string{
+				"RunPowerShellScript",
+				"RunShellScript",
+			}, false),
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d VirtualMachineRunCommandDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"stdout": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"stderr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d VirtualMachineRunCommandDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			var config VirtualMachineRunCommandDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := virtualmachines.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			parameters := make([]virtualmachines.RunCommandInputParameter, 0)
+			for _, v := range config.Parameter {
+				parameters = append(parameters, virtualmachines.RunCommandInputParameter{
+					Name:  v.Name,
+					Value: v.Value,
+				})
+			}
+
+			// the action Run Command is used rather than a managed Run Command, since it's not persisted on the
+			// Virtual Machine - meaning the script runs each time this Data Source is refreshed
+			input := virtualmachines.RunCommandInput{
+				CommandId:  config.CommandId,
+				Parameters: pointer.To(parameters),
+				Script:     pointer.To(strings.Split(config.Script, "\n")),
+			}
+
+			future, err := client.RunCommand(ctx, *id, input)
+			if err != nil {
+				return fmt.Errorf("running the command on %s: %+v", *id, err)
+			}
+			if err := future.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the command to finish running on %s: %+v", *id, err)
+			}
+
+			lastResponse := future.Poller.LatestResponse()
+			if lastResponse == nil {
+				return fmt.Errorf("waiting for the command to finish running on %s: last response was nil", *id)
+			}
+
+			var result virtualmachines.RunCommandResult
+			if err := lastResponse.Unmarshal(&result); err != nil {
+				return fmt.Errorf("parsing the result of the command run on %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRunCommandDataSourceModel{
+				VirtualMachineId: id.ID(),
+				CommandId:        config.CommandId,
+				Script:           config.Script,
+				Parameter:        config.Parameter,
+			}
+			state.Stdout, state.Stderr = flattenVirtualMachineRunCommandResult(result)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenVirtualMachineRunCommandResult returns the stdout and stderr of a command. Windows Virtual Machines return
+// these as separate statuses, whereas Linux Virtual Machines return a single status which contains both sections
+func flattenVirtualMachineRunCommandResult(input virtualmachines.RunCommandResult) (stdout string, stderr string) {
+	if input.Value == nil {
+		return "", ""
+	}
+
+	for _, v := range *input.Value {
+		code := strings.ToLower(pointer.From(v.Code))
+		message := pointer.From(v.Message)
+
+		switch {
+		case strings.Contains(code, "/stdout/"):
+			stdout = message
+		case strings.Contains(code, "/stderr/"):
+			stderr = message
+		case strings.Contains(message, "[stdout]"):
+			stdoutStart := strings.Index(message, "[stdout]") + len("[stdout]")
+			stderrStart := strings.Index(message, "[stderr]")
+			if stderrStart < stdoutStart {
+				stdout = strings.TrimSpace(message[stdoutStart:])
+				continue
+			}
+			stdout = strings.TrimSpace(message[stdoutStart:stderrStart])
+			stderr = strings.TrimSpace(message[stderrStart+len("[stderr]"):])
+		}
+	}
+
+	return stdout, stderr
+}
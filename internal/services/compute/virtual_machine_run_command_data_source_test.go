@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineRunCommandDataSource struct{}
+
+func TestAccVirtualMachineRunCommandDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("stdout").HasValue("hello world"),
+				check.That(data.ResourceName).Key("stderr").HasValue("oops"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualMachineRunCommandDataSource_parameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.parameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("stdout").HasValue("hello terraform"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineRunCommandDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_virtual_machine_run_command" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  script             = <<SCRIPT
+echo 'hello world'
+echo 'oops' >&2
+SCRIPT
+}
+`, VirtualMachineRunCommandTestResource{}.template(data))
+}
+
+func (VirtualMachineRunCommandDataSource) parameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_virtual_machine_run_command" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  script             = "echo \"hello $1\""
+
+  parameter {
+    name  = "arg1"
+    value = "terraform"
+  }
+}
+`, VirtualMachineRunCommandTestResource{}.template(data))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+func TestFlattenVirtualMachineRunCommandResult(t *testing.T) {
+	testData := []struct {
+		Name           string
+		Input          virtualmachines.RunCommandResult
+		ExpectedStdout string
+		ExpectedStderr string
+	}{
+		{
+			Name:  "no statuses",
+			Input: virtualmachines.RunCommandResult{},
+		},
+		{
+			Name: "windows",
+			Input: virtualmachines.RunCommandResult{
+				Value: &[]virtualmachines.InstanceViewStatus{
+					{Code: pointer.To("ComponentStatus/StdOut/succeeded"), Message: pointer.To("hello world")},
+					{Code: pointer.To("ComponentStatus/StdErr/succeeded"), Message: pointer.To("oops")},
+				},
+			},
+			ExpectedStdout: "hello world",
+			ExpectedStderr: "oops",
+		},
+		{
+			Name: "linux",
+			Input: virtualmachines.RunCommandResult{
+				Value: &[]virtualmachines.InstanceViewStatus{
+					{Code: pointer.To("ProvisioningState/succeeded"), Message: pointer.To("Enable succeeded: \n[stdout]\nhello world\n\n[stderr]\noops\n")},
+				},
+			},
+			ExpectedStdout: "hello world",
+			ExpectedStderr: "oops",
+		},
+		{
+			Name: "linux without stderr",
+			Input: virtualmachines.RunCommandResult{
+				Value: &[]virtualmachines.InstanceViewStatus{
+					{Code: pointer.To("ProvisioningState/succeeded"), Message: pointer.To("Enable succeeded: \n[stdout]\nhello world\n")},
+				},
+			},
+			ExpectedStdout: "hello world",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		stdout, stderr := flattenVirtualMachineRunCommandResult(v.Input)
+		if stdout != v.ExpectedStdout {
+			t.Fatalf("expected stdout to be %q but got %q", v.ExpectedStdout, stdout)
+		}
+		if stderr != v.ExpectedStderr {
+			t.Fatalf("expected stderr to be %q but got %q", v.ExpectedStderr, stderr)
+		}
+	}
+}
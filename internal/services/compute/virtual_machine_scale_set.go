@@ -1743,6 +1743,10 @@ func VirtualMachineScaleSetRollingUpgradePolicySchema() *pluginsdk.Schema {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
 				},
+				"rollback_failed_instances_on_policy_breach_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
 			},
 		},
 	}
@@ -1764,6 +1768,8 @@ func ExpandVirtualMachineScaleSetRollingUpgradePolicy(input []interface{}, isZon
 		MaxSurge:                            pointer.To(raw["maximum_surge_instances_enabled"].(bool)),
 	}
 
+	rollingUpgradePolicy.RollbackFailedInstancesOnPolicyBreach = pointer.To(raw["rollback_failed_instances_on_policy_breach_enabled"].(bool))
+
 	enableCrossZoneUpgrade := raw["cross_zone_upgrades_enabled"].(bool)
 	if isZonal {
 		// EnableCrossZoneUpgrade can only be set when for zonal scale set
@@ -1821,6 +1827,11 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *virtualmachinescal
 		maxSurge = *input.MaxSurge
 	}
 
+	rollbackFailedInstancesOnPolicyBreach := false
+	if input.RollbackFailedInstancesOnPolicyBreach != nil {
+		rollbackFailedInstancesOnPolicyBreach = *input.RollbackFailedInstancesOnPolicyBreach
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"cross_zone_upgrades_enabled":             enableCrossZoneUpgrade,
@@ -1830,6 +1841,8 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *virtualmachinescal
 			"pause_time_between_batches":              pauseTimeBetweenBatches,
 			"prioritize_unhealthy_instances_enabled":  prioritizeUnhealthyInstances,
 			"maximum_surge_instances_enabled":         maxSurge,
+
+			"rollback_failed_instances_on_policy_breach_enabled": rollbackFailedInstancesOnPolicyBreach,
 		},
 	}
 }
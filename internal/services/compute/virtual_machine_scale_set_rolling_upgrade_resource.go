@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineScaleSetRollingUpgradeResource struct{}
+
+type VirtualMachineScaleSetRollingUpgradeModel struct {
+	VirtualMachineScaleSetId string                                         `tfschema:"virtual_machine_scale_set_id"`
+	Triggers                 map[string]string                              `tfschema:"triggers"`
+	Status                   string                                         `tfschema:"status"`
+	StartTime                string                                         `tfschema:"start_time"`
+	LastActionTime           string                                         `tfschema:"last_action_time"`
+	Progress                 []VirtualMachineScaleSetRollingUpgradeProgress `tfschema:"progress"`
+	Batch                    []VirtualMachineScaleSetRollingUpgradeBatch    `tfschema:"batch"`
+}
+
+type VirtualMachineScaleSetRollingUpgradeProgress struct {
+	SuccessfulInstanceCount int64 `tfschema:"successful_instance_count"`
+	FailedInstanceCount     int64 `tfschema:"failed_instance_count"`
+	InProgressInstanceCount int64 `tfschema:"in_progress_instance_count"`
+	PendingInstanceCount    int64 `tfschema:"pending_instance_count"`
+}
+
+type VirtualMachineScaleSetRollingUpgradeBatch struct {
+	SuccessfulInstanceCount int64  `tfschema:"successful_instance_count"`
+	FailedInstanceCount     int64  `tfschema:"failed_instance_count"`
+	CompletedTime           string `tfschema:"completed_time"`
+}
+
+var _ sdk.Resource = VirtualMachineScaleSetRollingUpgradeResource{}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetRollingUpgradeModel{}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_rolling_upgrade"
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineScaleSetRollingUpgradeID
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineScaleSetID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_action_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"progress": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"successful_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"failed_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"in_progress_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"pending_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"batch": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"successful_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"failed_instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"completed_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+			rollingUpgradesClient := metadata.Client.Compute.VirtualMachineScaleSetRollingUpgradesClient
+
+			var model VirtualMachineScaleSetRollingUpgradeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId, err := virtualmachinescalesets.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineScaleSetRollingUpgradeID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName, "latest")
+
+			locks.ByID(scaleSetId.ID())
+			defer locks.UnlockByID(scaleSetId.ID())
+
+			existing, err := client.Get(ctx, *scaleSetId, virtualmachinescalesets.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *scaleSetId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *scaleSetId)
+			}
+
+			// the health of each batch is what gates the rolling upgrade moving on to the next batch, so without
+			// a health signal every instance would be upgraded regardless of whether the previous batch came back healthy
+			if !virtualMachineScaleSetHasHealthSignal(existing.Model.Properties) {
+				return fmt.Errorf("a rolling upgrade can only be started for %s when a `health_probe_id` or an Application Health Extension is configured", *scaleSetId)
+			}
+
+			rollingUpgradeId := virtualmachinescalesetrollingupgrades.NewVirtualMachineScaleSetID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName)
+
+			// the previous rolling upgrade is returned as the latest until the one started below is picked up,
+			// so its start time is used to tell the two apart
+			previousStartTime := ""
+			previous, err := rollingUpgradesClient.GetLatest(ctx, rollingUpgradeId)
+			if err != nil {
+				if !response.WasNotFound(previous.HttpResponse) {
+					return fmt.Errorf("retrieving the latest rolling upgrade for %s: %+v", *scaleSetId, err)
+				}
+			} else if previous.Model != nil && previous.Model.Properties != nil && previous.Model.Properties.RunningStatus != nil {
+				previousStartTime = pointer.From(previous.Model.Properties.RunningStatus.StartTime)
+			}
+
+			metadata.Logger.Infof("starting OS rolling upgrade for %s", *scaleSetId)
+			if _, err := rollingUpgradesClient.StartOSUpgrade(ctx, rollingUpgradeId); err != nil {
+				return fmt.Errorf("starting OS rolling upgrade for %s: %+v", *scaleSetId, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			batches := make([]VirtualMachineScaleSetRollingUpgradeBatch, 0)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending: []string{
+					"Waiting",
+					string(virtualmachinescalesetrollingupgrades.RollingUpgradeStatusCodeRollingForward),
+				},
+				Target: []string{
+					string(virtualmachinescalesetrollingupgrades.RollingUpgradeStatusCodeCancelled),
+					string(virtualmachinescalesetrollingupgrades.RollingUpgradeStatusCodeCompleted),
+					string(virtualmachinescalesetrollingupgrades.RollingUpgradeStatusCodeFaulted),
+				},
+				Refresh:      virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx, rollingUpgradesClient, rollingUpgradeId, previousStartTime, &batches),
+				MinTimeout:   15 * time.Second,
+				PollInterval: 15 * time.Second,
+				Timeout:      time.Until(deadline),
+			}
+
+			result, err := stateConf.WaitForStateContext(ctx)
+			if err != nil {
+				return fmt.Errorf("waiting for the OS rolling upgrade for %s to finish: %+v", *scaleSetId, err)
+			}
+
+			latest := result.(*virtualmachinescalesetrollingupgrades.RollingUpgradeStatusInfo)
+			flattenVirtualMachineScaleSetRollingUpgradeStatus(latest, &model)
+			model.Batch = batches
+
+			// the rolling upgrade stops when the health of an upgraded batch breaches the rolling upgrade policy, in which
+			// case the instances which failed are rolled back if `rollback_failed_instances_on_policy_breach_enabled` is set
+			if !strings.EqualFold(model.Status, string(virtualmachinescalesetrollingupgrades.RollingUpgradeStatusCodeCompleted)) {
+				message := ""
+				if latest.Properties != nil && latest.Properties.Error != nil {
+					message = pointer.From(latest.Properties.Error.Message)
+				}
+				progress := VirtualMachineScaleSetRollingUpgradeProgress{}
+				if len(model.Progress) > 0 {
+					progress = model.Progress[0]
+				}
+				return fmt.Errorf("the OS rolling upgrade for %s finished with the status %q after %d batches (%d instances upgraded successfully, %d instances failed): %s", *scaleSetId, model.Status, len(batches), progress.SuccessfulInstanceCount, progress.FailedInstanceCount, message)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+
+			id, err := parse.VirtualMachineScaleSetRollingUpgradeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			scaleSetId := virtualmachinescalesets.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+			existing, err := client.Get(ctx, scaleSetId, virtualmachinescalesets.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", scaleSetId, err)
+			}
+
+			// the status of the rolling upgrade started by this resource is retained, since a later
+			// rolling upgrade (e.g. an automatic OS upgrade) would otherwise be reported in its place
+			var state VirtualMachineScaleSetRollingUpgradeModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.VirtualMachineScaleSetId = scaleSetId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: a completed rolling upgrade can't be reverted, so removing this resource doesn't change the Virtual Machine Scale Set
+			return nil
+		},
+	}
+}
+
+func virtualMachineScaleSetHasHealthSignal(input *virtualmachinescalesets.VirtualMachineScaleSetProperties) bool {
+	if input.VirtualMachineProfile == nil {
+		return false
+	}
+
+	if networkProfile := input.VirtualMachineProfile.NetworkProfile; networkProfile != nil && networkProfile.HealthProbe != nil && pointer.From(networkProfile.HealthProbe.Id) != "" {
+		return true
+	}
+
+	if extensionProfile := input.VirtualMachineProfile.ExtensionProfile; extensionProfile != nil && extensionProfile.Extensions != nil {
+		for _, extension := range *extensionProfile.Extensions {
+			if extension.Properties == nil {
+				continue
+			}
+
+			extensionType := pointer.From(extension.Properties.Type)
+			if extensionType == "ApplicationHealthLinux" || extensionType == "ApplicationHealthWindows" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// virtualMachineScaleSetRollingUpgradeRefreshFunc polls the latest rolling upgrade, recording a batch each time more
+// instances have finished upgrading since the previous poll - since the API only exposes the overall progress
+func virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx context.Context, client *virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetRollingUpgradesClient, id virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetId, previousStartTime string, batches *[]VirtualMachineScaleSetRollingUpgradeBatch) pluginsdk.StateRefreshFunc {
+	var successful, failed int64
+	return func() (interface{}, string, error) {
+		resp, err := client.GetLatest(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the latest rolling upgrade for %s: %+v", id, err)
+		}
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.RunningStatus == nil {
+			return resp.Model, "Waiting", nil
+		}
+
+		status := resp.Model.Properties.RunningStatus
+		if pointer.From(status.StartTime) == previousStartTime {
+			return resp.Model, "Waiting", nil
+		}
+
+		if progress := resp.Model.Properties.Progress; progress != nil {
+			successfulInstanceCount := pointer.From(progress.SuccessfulInstanceCount)
+			failedInstanceCount := pointer.From(progress.FailedInstanceCount)
+			if successfulInstanceCount+failedInstanceCount > successful+failed {
+				*batches = append(*batches, VirtualMachineScaleSetRollingUpgradeBatch{
+					SuccessfulInstanceCount: successfulInstanceCount - successful,
+					FailedInstanceCount:     failedInstanceCount - failed,
+					CompletedTime:           pointer.From(status.LastActionTime),
+				})
+				successful = successfulInstanceCount
+				failed = failedInstanceCount
+			}
+		}
+
+		return resp.Model, string(pointer.From(status.Code)), nil
+	}
+}
+
+func flattenVirtualMachineScaleSetRollingUpgradeStatus(input *virtualmachinescalesetrollingupgrades.RollingUpgradeStatusInfo, output *VirtualMachineScaleSetRollingUpgradeModel) {
+	output.Progress = make([]VirtualMachineScaleSetRollingUpgradeProgress, 0)
+	if input == nil || input.Properties == nil {
+		return
+	}
+
+	if status := input.Properties.RunningStatus; status != nil {
+		output.Status = string(pointer.From(status.Code))
+		output.StartTime = pointer.From(status.StartTime)
+		output.LastActionTime = pointer.From(status.LastActionTime)
+	}
+
+	if progress := input.Properties.Progress; progress != nil {
+		output.Progress = append(output.Progress, VirtualMachineScaleSetRollingUpgradeProgress{
+			SuccessfulInstanceCount: pointer.From(progress.SuccessfulInstanceCount),
+			FailedInstanceCount:     pointer.From(progress.FailedInstanceCount),
+			InProgressInstanceCount: pointer.From(progress.InProgressInstanceCount),
+			PendingInstanceCount:    pointer.From(progress.PendingInstanceCount),
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineScaleSetRollingUpgradeResource struct{}
+
+func TestAccVirtualMachineScaleSetRollingUpgrade_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_rolling_upgrade", "test")
+	r := VirtualMachineScaleSetRollingUpgradeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Completed"),
+				check.That(data.ResourceName).Key("progress.0.failed_instance_count").HasValue("0"),
+				check.That(data.ResourceName).Key("batch.0.failed_instance_count").HasValue("0"),
+			),
+		},
+		data.ImportStep("triggers", "status", "start_time", "last_action_time", "progress", "batch"),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Completed"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualMachineScaleSetRollingUpgrade_withoutHealthSignal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_rolling_upgrade", "test")
+	r := VirtualMachineScaleSetRollingUpgradeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withoutHealthSignal(data),
+			ExpectError: regexp.MustCompile("a rolling upgrade can only be started"),
+		},
+	})
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetRollingUpgradeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scaleSetId := virtualmachinescalesets.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	resp, err := client.Compute.VirtualMachineScaleSetsClient.Get(ctx, scaleSetId, virtualmachinescalesets.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", scaleSetId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                            = "acctestvmss-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  sku                             = "Standard_F2"
+  instances                       = 2
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssword1234!"
+  disable_password_authentication = false
+  upgrade_mode                    = "Rolling"
+
+  rolling_upgrade_policy {
+    max_batch_instance_percent                         = 50
+    max_unhealthy_instance_percent                     = 50
+    max_unhealthy_upgraded_instance_percent            = 50
+    pause_time_between_batches                         = "PT30S"
+    rollback_failed_instances_on_policy_breach_enabled = true
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  extension {
+    name                       = "HealthExtension"
+    publisher                  = "Microsoft.ManagedServices"
+    type                       = "ApplicationHealthLinux"
+    type_handler_version       = "1.0"
+    auto_upgrade_minor_version = true
+    settings = jsonencode({
+      protocol = "tcp"
+      port     = 22
+    })
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_rolling_upgrade" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+
+  triggers = {
+    image = "%s"
+  }
+}
+`, LinuxVirtualMachineScaleSetResource{}.template(data), data.RandomInteger, trigger)
+}
+
+func (r VirtualMachineScaleSetRollingUpgradeResource) withoutHealthSignal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                            = "acctestvmss-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  sku                             = "Standard_F2"
+  instances                       = 1
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssword1234!"
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_rolling_upgrade" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+}
+`, LinuxVirtualMachineScaleSetResource{}.template(data), data.RandomInteger)
+}
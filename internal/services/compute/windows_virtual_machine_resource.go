@@ -4,6 +4,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservationgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
@@ -53,6 +55,12 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("resource_group_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return !meta.(*clients.Client).Features.ResourceGroup.MoveResourcesOnResourceGroupChange
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -61,7 +69,12 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.VirtualMachineName,
 			},
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			// NOTE: this is only ForceNew when moving resources between Resource Groups is disabled in the `features` block
+			"resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: resourcegroups.ValidateName,
+			},
 
 			"location": commonschema.Location(),
 
@@ -1086,6 +1099,15 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
 	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
 
+	if d.HasChange("resource_group_name") {
+		newId := virtualmachines.NewVirtualMachineID(id.SubscriptionId, d.Get("resource_group_name").(string), id.VirtualMachineName)
+		if err := moveVirtualMachine(ctx, meta.(*clients.Client), *id, newId); err != nil {
+			return err
+		}
+		id = &newId
+		d.SetId(id.ID())
+	}
+
 	log.Printf("[DEBUG] Retrieving Windows %s", id)
 	options := virtualmachines.DefaultGetOperationOptions()
 	options.Expand = pointer.To(virtualmachines.InstanceViewTypesUserData)
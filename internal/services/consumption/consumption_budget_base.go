@@ -22,6 +22,9 @@ import (
 
 type consumptionBudgetBaseResource struct{}
 
+// the 2019-10-01 API only defines `Actual`, however `Forecasted` is supported by the service for all budget scopes
+const thresholdTypeForecasted budgets.ThresholdType = "Forecasted"
+
 func getDimensionNames() []string {
 	return []string{
 		"ChargeType",
@@ -83,12 +86,10 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 									ValidateFunc: validation.StringInSlice(getDimensionNames(), false),
 								},
 								"operator": {
-									Type:     pluginsdk.TypeString,
-									Optional: true,
-									Default:  "In",
-									ValidateFunc: validation.StringInSlice([]string{
-										"In",
-									}, false),
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(budgets.BudgetOperatorTypeIn),
+									ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForBudgetOperatorType(), false),
 								},
 								"values": {
 									Type:     pluginsdk.TypeList,
@@ -113,15 +114,14 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 									Required: true,
 								},
 								"operator": {
-									Type:     pluginsdk.TypeString,
-									Optional: true,
-									Default:  "In",
-									ValidateFunc: validation.StringInSlice([]string{
-										"In",
-									}, false),
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(budgets.BudgetOperatorTypeIn),
+									ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForBudgetOperatorType(), false),
 								},
 								"values": {
 									Type:     pluginsdk.TypeList,
+									MinItems: 1,
 									Required: true,
 									Elem: &pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
@@ -152,19 +152,7 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 						Required:     true,
 						ValidateFunc: validation.IntBetween(0, 1000),
 					},
-					// Issue: https://github.com/Azure/azure-rest-api-specs/issues/16240
-					// Toggling between these two values doesn't work at the moment and also doesn't throw an error
-					// but it seems unlikely that a user would switch the threshold_type of their budgets frequently
-					"threshold_type": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						Default:  string(budgets.ThresholdTypeActual),
-						ForceNew: true, // TODO: remove this when the above issue is fixed
-						ValidateFunc: validation.StringInSlice([]string{
-							string(budgets.ThresholdTypeActual),
-							"Forecasted",
-						}, false),
-					},
+					"threshold_type": consumptionBudgetThresholdTypeSchema(),
 					"operator": {
 						Type:     pluginsdk.TypeString,
 						Required: true,
@@ -228,10 +216,11 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"start_date": {
-						Type:         pluginsdk.TypeString,
-						Required:     true,
-						ValidateFunc: validate.ConsumptionBudgetTimePeriodStartDate,
-						ForceNew:     true,
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     validate.ConsumptionBudgetTimePeriodStartDate,
+						DiffSuppressFunc: suppressConsumptionBudgetStartDateDiff,
+						ForceNew:         true,
 					},
 					"end_date": {
 						Type:         pluginsdk.TypeString,
@@ -266,12 +255,10 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 									ValidateFunc: validation.StringInSlice(getDimensionNames(), false),
 								},
 								"operator": {
-									Type:     pluginsdk.TypeString,
-									Optional: true,
-									Default:  "In",
-									ValidateFunc: validation.StringInSlice([]string{
-										"In",
-									}, false),
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(budgets.BudgetOperatorTypeIn),
+									ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForBudgetOperatorType(), false),
 								},
 								"values": {
 									Type:     pluginsdk.TypeList,
@@ -297,15 +284,14 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 									Required: true,
 								},
 								"operator": {
-									Type:     pluginsdk.TypeString,
-									Optional: true,
-									Default:  "In",
-									ValidateFunc: validation.StringInSlice([]string{
-										"In",
-									}, false),
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(budgets.BudgetOperatorTypeIn),
+									ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForBudgetOperatorType(), false),
 								},
 								"values": {
 									Type:     pluginsdk.TypeList,
+									MinItems: 1,
 									Required: true,
 									Elem: &pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
@@ -329,6 +315,71 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 	return output
 }
 
+// Issue: https://github.com/Azure/azure-rest-api-specs/issues/16240
+// Toggling between these two values doesn't work at the moment and also doesn't throw an error
+// but it seems unlikely that a user would switch the threshold_type of their budgets frequently
+func consumptionBudgetThresholdTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(budgets.ThresholdTypeActual),
+		ForceNew: true, // TODO: remove this when the above issue is fixed
+		ValidateFunc: validation.StringInSlice([]string{
+			string(budgets.ThresholdTypeActual),
+			string(thresholdTypeForecasted),
+		}, false),
+	}
+}
+
+// suppressConsumptionBudgetStartDateDiff avoids recreating a budget when the API returns the start date in a
+// different format, or when a start date in the past has been moved forward by the service to the start of the
+// current period - which is determined from the configured start date and the `time_grain` of the budget
+func suppressConsumptionBudgetStartDateDiff(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldDate, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newDate, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	if oldDate.Equal(newDate) {
+		return true
+	}
+
+	periodStart, ok := consumptionBudgetCurrentPeriodStart(newDate, d.Get("time_grain").(string), time.Now().UTC())
+	return ok && oldDate.Equal(periodStart)
+}
+
+// consumptionBudgetCurrentPeriodStart returns the start of the period of the given time grain which contains `now`,
+// for a budget starting at `startDate` - or false if the budget hasn't started yet
+func consumptionBudgetCurrentPeriodStart(startDate time.Time, timeGrain string, now time.Time) (time.Time, bool) {
+	if startDate.After(now) {
+		return time.Time{}, false
+	}
+
+	months := 1
+	switch budgets.TimeGrainType(timeGrain) {
+	case budgets.TimeGrainTypeQuarterly, budgets.TimeGrainTypeBillingQuarter:
+		months = 3
+	case budgets.TimeGrainTypeAnnually, budgets.TimeGrainTypeBillingAnnual:
+		months = 12
+	}
+
+	periods := ((now.Year()-startDate.Year())*12 + int(now.Month()) - int(startDate.Month())) / months
+	periodStart := startDate.AddDate(0, periods*months, 0)
+	if periodStart.After(now) {
+		periodStart = startDate.AddDate(0, (periods-1)*months, 0)
+	}
+
+	return periodStart, true
+}
+
 func (br consumptionBudgetBaseResource) attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -529,6 +580,9 @@ func flattenConsumptionBudgetTimePeriod(input *budgets.BudgetTimePeriod) []inter
 	}
 
 	startDate := input.StartDate
+	if v, err := time.Parse(time.RFC3339, startDate); err == nil {
+		startDate = v.UTC().Format(time.RFC3339)
+	}
 
 	endDate := ""
 	if v := input.EndDate; v != nil {
@@ -651,7 +705,7 @@ func flattenConsumptionBudgetComparisonExpression(input *budgets.BudgetCompariso
 	consumptionBudgetComparisonExpression := make(map[string]interface{})
 
 	consumptionBudgetComparisonExpression["name"] = input.Name
-	consumptionBudgetComparisonExpression["operator"] = input.Operator
+	consumptionBudgetComparisonExpression["operator"] = string(input.Operator)
 	consumptionBudgetComparisonExpression["values"] = utils.FlattenStringSlice(&input.Values)
 
 	return &consumptionBudgetComparisonExpression
@@ -774,7 +828,8 @@ func flattenConsumptionBudgetFilter(input *budgets.BudgetFilter) []interface{} {
 		for _, v := range *input.And {
 			if v.Dimensions != nil {
 				dimensions = append(dimensions, flattenConsumptionBudgetComparisonExpression(v.Dimensions))
-			} else {
+			}
+			if v.Tags != nil {
 				tags = append(tags, flattenConsumptionBudgetComparisonExpression(v.Tags))
 			}
 		}
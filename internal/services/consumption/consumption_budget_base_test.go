@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consumption
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
+)
+
+func TestConsumptionBudgetCurrentPeriodStart(t *testing.T) {
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		StartDate string
+		TimeGrain budgets.TimeGrainType
+		Expected  string
+		Started   bool
+	}{
+		{
+			StartDate: "2024-06-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeMonthly,
+			Started:   false,
+		},
+		{
+			StartDate: "2024-05-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeMonthly,
+			Expected:  "2024-05-01T00:00:00Z",
+			Started:   true,
+		},
+		{
+			StartDate: "2023-01-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeMonthly,
+			Expected:  "2024-05-01T00:00:00Z",
+			Started:   true,
+		},
+		{
+			StartDate: "2023-01-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeQuarterly,
+			Expected:  "2024-04-01T00:00:00Z",
+			Started:   true,
+		},
+		{
+			StartDate: "2023-02-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeBillingQuarter,
+			Expected:  "2024-05-01T00:00:00Z",
+			Started:   true,
+		},
+		{
+			StartDate: "2022-06-01T00:00:00Z",
+			TimeGrain: budgets.TimeGrainTypeAnnually,
+			Expected:  "2023-06-01T00:00:00Z",
+			Started:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		startDate, err := time.Parse(time.RFC3339, tc.StartDate)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", tc.StartDate, err)
+		}
+
+		actual, started := consumptionBudgetCurrentPeriodStart(startDate, string(tc.TimeGrain), now)
+		if started != tc.Started {
+			t.Fatalf("expected %q with a time grain of %q to have started %t, got %t", tc.StartDate, tc.TimeGrain, tc.Started, started)
+		}
+		if started && actual.Format(time.RFC3339) != tc.Expected {
+			t.Fatalf("expected %q with a time grain of %q to have a current period start of %q, got %q", tc.StartDate, tc.TimeGrain, tc.Expected, actual.Format(time.RFC3339))
+		}
+	}
+}
@@ -54,19 +54,7 @@ func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Sche
 						Required:     true,
 						ValidateFunc: validation.IntBetween(0, 1000),
 					},
-					// Issue: https://github.com/Azure/azure-rest-api-specs/issues/16240
-					// Toggling between these two values doesn't work at the moment and also doesn't throw an error
-					// but it seems unlikely that a user would switch the threshold_type of their budgets frequently
-					"threshold_type": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						Default:  string(budgets.ThresholdTypeActual),
-						ForceNew: true, // TODO: remove this when the above issue is fixed
-						ValidateFunc: validation.StringInSlice([]string{
-							string(budgets.ThresholdTypeActual),
-							"Forecasted",
-						}, false),
-					},
+					"threshold_type": consumptionBudgetThresholdTypeSchema(),
 					"operator": {
 						Type:     pluginsdk.TypeString,
 						Required: true,
@@ -118,6 +118,21 @@ func TestAccConsumptionBudgetSubscription_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccConsumptionBudgetSubscription_pastStartDateForecastedTagFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_subscription", "test")
+	r := ConsumptionBudgetSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pastStartDateForecastedTagFilter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("time_period.0.start_date"),
+	})
+}
+
 func (ConsumptionBudgetSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := budgets.ParseScopedBudgetID(state.ID)
 	if err != nil {
@@ -433,3 +448,54 @@ resource "azurerm_consumption_budget_subscription" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
 }
+
+func (ConsumptionBudgetSubscriptionResource) pastStartDateForecastedTagFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {}
+
+resource "azurerm_consumption_budget_subscription" "test" {
+  name            = "acctestconsumptionbudgetsubscription-%d"
+  subscription_id = data.azurerm_subscription.test.id
+
+  amount     = 1000
+  time_grain = "Annually"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  filter {
+    tag {
+      name     = "environment"
+      operator = "In"
+      values = [
+        "dev",
+        "test",
+      ]
+    }
+
+    tag {
+      name = "team"
+      values = [
+        "platform",
+      ]
+    }
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 80.0
+    operator       = "GreaterThan"
+    threshold_type = "Forecasted"
+
+    contact_emails = [
+      "foo@example.com",
+    ]
+  }
+}
+`, data.RandomInteger, consumptionBudgetTestStartDate().AddDate(0, -2, 0).Format(time.RFC3339))
+}
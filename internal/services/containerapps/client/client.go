@@ -12,18 +12,22 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironmentsstorages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/connectedenvironments"
+	connectedEnvironmentDaprComponents "github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	CertificatesClient         *certificates.CertificatesClient
-	ContainerAppClient         *containerapps.ContainerAppsClient
-	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
-	DaprComponentsClient       *daprcomponents.DaprComponentsClient
-	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
-	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
-	JobClient                  *jobs.JobsClient
+	CertificatesClient                       *certificates.CertificatesClient
+	ConnectedEnvironmentClient               *connectedenvironments.ConnectedEnvironmentsClient
+	ConnectedEnvironmentDaprComponentsClient *connectedEnvironmentDaprComponents.DaprComponentsClient
+	ContainerAppClient                       *containerapps.ContainerAppsClient
+	ContainerAppRevisionClient               *containerappsrevisions.ContainerAppsRevisionsClient
+	DaprComponentsClient                     *daprcomponents.DaprComponentsClient
+	ManagedEnvironmentClient                 *managedenvironments.ManagedEnvironmentsClient
+	StorageClient                            *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
+	JobClient                                *jobs.JobsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -33,6 +37,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(certificatesClient.Client, o.Authorizers.ResourceManager)
 
+	connectedEnvironmentClient, err := connectedenvironments.NewConnectedEnvironmentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Connected Environment client : %+v", err)
+	}
+	o.Configure(connectedEnvironmentClient.Client, o.Authorizers.ResourceManager)
+
+	connectedEnvironmentDaprComponentsClient, err := connectedEnvironmentDaprComponents.NewDaprComponentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Connected Environment Dapr Components client : %+v", err)
+	}
+	o.Configure(connectedEnvironmentDaprComponentsClient.Client, o.Authorizers.ResourceManager)
+
 	containerAppsClient, err := containerapps.NewContainerAppsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Container Apps client : %+v", err)
@@ -70,12 +86,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		CertificatesClient:         certificatesClient,
-		ContainerAppClient:         containerAppsClient,
-		ContainerAppRevisionClient: containerAppsRevisionsClient,
-		DaprComponentsClient:       daprComponentClient,
-		ManagedEnvironmentClient:   managedEnvironmentClient,
-		StorageClient:              managedEnvironmentStoragesClient,
-		JobClient:                  jobsClient,
+		CertificatesClient:                       certificatesClient,
+		ConnectedEnvironmentClient:               connectedEnvironmentClient,
+		ConnectedEnvironmentDaprComponentsClient: connectedEnvironmentDaprComponentsClient,
+		ContainerAppClient:                       containerAppsClient,
+		ContainerAppRevisionClient:               containerAppsRevisionsClient,
+		DaprComponentsClient:                     daprComponentClient,
+		ManagedEnvironmentClient:                 managedEnvironmentClient,
+		StorageClient:                            managedEnvironmentStoragesClient,
+		JobClient:                                jobsClient,
 	}, nil
 }
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppConnectedEnvironmentCertificateResource struct{}
+
+type ContainerAppConnectedEnvironmentCertificateModel struct {
+	Name                   string                 `tfschema:"name"`
+	ConnectedEnvironmentId string                 `tfschema:"container_app_connected_environment_id"`
+	Tags                   map[string]interface{} `tfschema:"tags"`
+
+	// Write only?
+	CertificatePassword string `tfschema:"certificate_password"`
+	CertificateBlob     string `tfschema:"certificate_blob_base64"`
+
+	// Read Only
+	SubjectName    string `tfschema:"subject_name"`
+	Issuer         string `tfschema:"issuer"`
+	IssueDate      string `tfschema:"issue_date"`
+	ExpirationDate string `tfschema:"expiration_date"`
+	Thumbprint     string `tfschema:"thumbprint"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppConnectedEnvironmentCertificateResource{}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) ModelObject() interface{} {
+	return &ContainerAppConnectedEnvironmentCertificateModel{}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) ResourceType() string {
+	return "azurerm_container_app_connected_environment_certificate"
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectedenvironments.ValidateConnectedEnvironmentCertificateID
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName,
+			Description:  "The name of the Container Apps Connected Environment Certificate.",
+		},
+
+		"container_app_connected_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: connectedenvironments.ValidateConnectedEnvironmentID,
+			Description:  "The Container App Connected Environment ID to configure this Certificate on.",
+		},
+
+		"certificate_blob_base64": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsBase64,
+			Description:  "The Certificate Private Key as a base64 encoded PFX or PEM.",
+		},
+
+		"certificate_password": {
+			Type:        pluginsdk.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The password for the Certificate.",
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subject_name": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The Subject Name for the Certificate.",
+		},
+
+		"issuer": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The Certificate Issuer.",
+		},
+
+		"issue_date": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The date of issue for the Certificate.",
+		},
+
+		"expiration_date": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The expiration date for the Certificate.",
+		},
+
+		"thumbprint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The Thumbprint of the Certificate.",
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			var cert ContainerAppConnectedEnvironmentCertificateModel
+
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			envId, err := connectedenvironments.ParseConnectedEnvironmentID(cert.ConnectedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := connectedenvironments.NewConnectedEnvironmentCertificateID(metadata.Client.Account.SubscriptionId, envId.ResourceGroupName, envId.ConnectedEnvironmentName, cert.Name)
+
+			existing, err := client.CertificatesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			env, err := client.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+			if env.Model == nil {
+				return fmt.Errorf("reading %s for %s: model was nil", *envId, id)
+			}
+
+			model := connectedenvironments.Certificate{
+				Location: env.Model.Location,
+				Name:     pointer.To(id.CertificateName),
+				Properties: &connectedenvironments.CertificateProperties{
+					Password: pointer.To(cert.CertificatePassword),
+					Value:    pointer.To(cert.CertificateBlob),
+				},
+				Tags: tags.Expand(cert.Tags),
+			}
+
+			if _, err := client.CertificatesCreateOrUpdate(ctx, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.CertificatesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			var state ContainerAppConnectedEnvironmentCertificateModel
+
+			state.Name = id.CertificateName
+			state.ConnectedEnvironmentId = connectedenvironments.NewConnectedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ConnectedEnvironmentName).ID()
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				// The Certificate Blob and Password are not retrievable in any way, so grab them back from config if we can. Imports will need `ignore_changes`.
+				if certBlob, ok := metadata.ResourceData.GetOk("certificate_blob_base64"); ok {
+					state.CertificateBlob = certBlob.(string)
+				}
+
+				if certPassword, ok := metadata.ResourceData.GetOk("certificate_password"); ok {
+					state.CertificatePassword = certPassword.(string)
+				}
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = pointer.From(props.SubjectName)
+					state.Issuer = pointer.From(props.Issuer)
+					state.IssueDate = pointer.From(props.IssueDate)
+					state.ExpirationDate = pointer.From(props.ExpirationDate)
+					state.Thumbprint = pointer.From(props.Thumbprint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CertificatesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			var cert ContainerAppConnectedEnvironmentCertificateModel
+
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				patch := connectedenvironments.CertificatePatch{
+					Tags: tags.Expand(cert.Tags),
+				}
+
+				if _, err = client.CertificatesUpdate(ctx, *id, patch); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppConnectedEnvironmentCertificateResource struct{}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ContainerAppConnectedEnvironmentCertificateResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ContainerAppConnectedEnvironmentCertificateResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_updateTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ContainerAppConnectedEnvironmentCertificateResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+		{
+			Config: r.basicAddTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+	})
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectedenvironments.ParseConnectedEnvironmentCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ConnectedEnvironmentClient.CertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment_certificate" "test" {
+  name                                   = "acctest-cacecert%[2]d"
+  container_app_connected_environment_id = azurerm_container_app_connected_environment.test.id
+  certificate_blob_base64                = filebase64("testdata/testacc.pfx")
+  certificate_password                   = "TestAcc"
+}
+`, ContainerAppConnectedEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment_certificate" "import" {
+  name                                   = azurerm_container_app_connected_environment_certificate.test.name
+  container_app_connected_environment_id = azurerm_container_app_connected_environment_certificate.test.container_app_connected_environment_id
+  certificate_blob_base64                = azurerm_container_app_connected_environment_certificate.test.certificate_blob_base64
+  certificate_password                   = azurerm_container_app_connected_environment_certificate.test.certificate_password
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppConnectedEnvironmentCertificateResource) basicAddTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment_certificate" "test" {
+  name                                   = "acctest-cacecert%[2]d"
+  container_app_connected_environment_id = azurerm_container_app_connected_environment.test.id
+  certificate_blob_base64                = filebase64("testdata/testacc.pfx")
+  certificate_password                   = "TestAcc"
+
+  tags = {
+    env = "testAcc"
+  }
+}
+`, ContainerAppConnectedEnvironmentResource{}.basic(data), data.RandomInteger)
+}
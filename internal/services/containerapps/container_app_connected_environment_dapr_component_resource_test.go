@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppConnectedEnvironmentDaprComponentResource struct{}
+
+func TestAccContainerAppConnectedEnvironmentDaprComponent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_dapr_component", "test")
+	r := ContainerAppConnectedEnvironmentDaprComponentResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentDaprComponent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_dapr_component", "test")
+	r := ContainerAppConnectedEnvironmentDaprComponentResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentDaprComponent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_dapr_component", "test")
+	r := ContainerAppConnectedEnvironmentDaprComponentResource{}
+	ContainerAppConnectedEnvironmentResource{}.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppConnectedEnvironmentDaprComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := daprcomponents.ParseConnectedEnvironmentDaprComponentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ConnectedEnvironmentDaprComponentsClient.ConnectedEnvironmentsDaprComponentsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppConnectedEnvironmentDaprComponentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment_dapr_component" "test" {
+  name                                   = "acctest-dapr-%[2]d"
+  container_app_connected_environment_id = azurerm_container_app_connected_environment.test.id
+  component_type                         = "state.azure.blobstorage"
+  version                                = "v1"
+}
+`, ContainerAppConnectedEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppConnectedEnvironmentDaprComponentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment_dapr_component" "import" {
+  name                                   = azurerm_container_app_connected_environment_dapr_component.test.name
+  container_app_connected_environment_id = azurerm_container_app_connected_environment_dapr_component.test.container_app_connected_environment_id
+  component_type                         = azurerm_container_app_connected_environment_dapr_component.test.component_type
+  version                                = azurerm_container_app_connected_environment_dapr_component.test.version
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppConnectedEnvironmentDaprComponentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "container-app-storage"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_container_app_connected_environment_dapr_component" "test" {
+  name                                   = "acctest-dapr-%[2]d"
+  container_app_connected_environment_id = azurerm_container_app_connected_environment.test.id
+  component_type                         = "state.azure.blobstorage"
+  version                                = "v1"
+
+  init_timeout  = "10s"
+  ignore_errors = true
+
+  secret {
+    name  = "storage-account-access-key"
+    value = azurerm_storage_account.test.primary_access_key
+  }
+
+  metadata {
+    name        = "storage-account-key"
+    secret_name = "storage-account-access-key"
+  }
+
+  metadata {
+    name  = "storage-container-name"
+    value = azurerm_storage_container.test.name
+  }
+
+  scopes = ["testapp"]
+}
+`, ContainerAppConnectedEnvironmentResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/connectedenvironments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppConnectedEnvironmentResource struct{}
+
+type ContainerAppConnectedEnvironmentModel struct {
+	Name                   string                                   `tfschema:"name"`
+	ResourceGroup          string                                   `tfschema:"resource_group_name"`
+	Location               string                                   `tfschema:"location"`
+	CustomLocationId       string                                   `tfschema:"custom_location_id"`
+	StaticIP               string                                   `tfschema:"static_ip_address"`
+	DaprAIConnectionString string                                   `tfschema:"dapr_application_insights_connection_string"`
+	CustomDomain           []ContainerAppConnectedEnvironmentDomain `tfschema:"custom_domain"`
+	Tags                   map[string]string                        `tfschema:"tags"`
+
+	DefaultDomain              string `tfschema:"default_domain"`
+	CustomDomainVerificationId string `tfschema:"custom_domain_verification_id"`
+	DeploymentErrors           string `tfschema:"deployment_errors"`
+}
+
+type ContainerAppConnectedEnvironmentDomain struct {
+	DnsSuffix           string `tfschema:"dns_suffix"`
+	CertificateBlob     string `tfschema:"certificate_blob_base64"`
+	CertificatePassword string `tfschema:"certificate_password"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppConnectedEnvironmentResource{}
+
+func (r ContainerAppConnectedEnvironmentResource) ModelObject() interface{} {
+	return &ContainerAppConnectedEnvironmentModel{}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) ResourceType() string {
+	return "azurerm_container_app_connected_environment"
+}
+
+func (r ContainerAppConnectedEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectedenvironments.ValidateConnectedEnvironmentID
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedEnvironmentName,
+			Description:  "The name of the Container Apps Connected Environment.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": commonschema.ResourceIDReferenceRequiredForceNew(&customlocations.CustomLocationId{}),
+
+		"static_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsIPAddress,
+			Description:  "The Static IP address of the Connected Environment.",
+		},
+
+		"dapr_application_insights_connection_string": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Application Insights connection string used by Dapr to export Service to Service communication telemetry.",
+		},
+
+		"custom_domain": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_suffix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The DNS suffix for the Connected Environment's domain.",
+					},
+
+					"certificate_blob_base64": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsBase64,
+						Description:  "The bundle of Private Key and Certificate for the Custom DNS Suffix as a base64 encoded PFX or PEM.",
+					},
+
+					"certificate_password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The password for the Certificate bundle.",
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_domain": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The default publicly resolvable name of this Connected Environment.",
+		},
+
+		"custom_domain_verification_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The ID of the Custom Domain Verification for this Connected Environment.",
+		},
+
+		"deployment_errors": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "Any errors that occurred during the deployment of the Connected Environment.",
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var env ContainerAppConnectedEnvironmentModel
+			if err := metadata.Decode(&env); err != nil {
+				return err
+			}
+
+			id := connectedenvironments.NewConnectedEnvironmentID(subscriptionId, env.ResourceGroup, env.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := connectedenvironments.ConnectedEnvironment{
+				ExtendedLocation: &connectedenvironments.ExtendedLocation{
+					Name: pointer.To(env.CustomLocationId),
+					Type: pointer.To(connectedenvironments.ExtendedLocationTypesCustomLocation),
+				},
+				Location: location.Normalize(env.Location),
+				Properties: &connectedenvironments.ConnectedEnvironmentProperties{
+					CustomDomainConfiguration: expandConnectedEnvironmentCustomDomain(env.CustomDomain),
+				},
+				Tags: pointer.To(env.Tags),
+			}
+
+			if env.StaticIP != "" {
+				payload.Properties.StaticIP = pointer.To(env.StaticIP)
+			}
+
+			if env.DaprAIConnectionString != "" {
+				payload.Properties.DaprAIConnectionString = pointer.To(env.DaprAIConnectionString)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state := ContainerAppConnectedEnvironmentModel{
+				Name:          id.ConnectedEnvironmentName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if extendedLocation := model.ExtendedLocation; extendedLocation != nil {
+					state.CustomLocationId = pointer.From(extendedLocation.Name)
+				}
+
+				if props := model.Properties; props != nil {
+					state.StaticIP = pointer.From(props.StaticIP)
+					state.DefaultDomain = pointer.From(props.DefaultDomain)
+					state.DeploymentErrors = pointer.From(props.DeploymentErrors)
+
+					if domain := props.CustomDomainConfiguration; domain != nil {
+						state.CustomDomainVerificationId = pointer.From(domain.CustomDomainVerificationId)
+						if dnsSuffix := pointer.From(domain.DnsSuffix); dnsSuffix != "" {
+							// The Certificate Blob and Password are not returned by the API, so grab them back from config if we can.
+							customDomain := ContainerAppConnectedEnvironmentDomain{
+								DnsSuffix: dnsSuffix,
+							}
+							if certBlob, ok := metadata.ResourceData.GetOk("custom_domain.0.certificate_blob_base64"); ok {
+								customDomain.CertificateBlob = certBlob.(string)
+							}
+							if certPassword, ok := metadata.ResourceData.GetOk("custom_domain.0.certificate_password"); ok {
+								customDomain.CertificatePassword = certPassword.(string)
+							}
+							state.CustomDomain = []ContainerAppConnectedEnvironmentDomain{customDomain}
+						}
+					}
+				}
+			}
+
+			// The Dapr AI Connection String is not returned by the API, so grab it back from config if we can.
+			if connectionString, ok := metadata.ResourceData.GetOk("dapr_application_insights_connection_string"); ok {
+				state.DaprAIConnectionString = connectionString.(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var env ContainerAppConnectedEnvironmentModel
+			if err := metadata.Decode(&env); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			// the certificate is write-only, so the configured values must always be sent to retain the custom domain
+			payload.Properties.CustomDomainConfiguration = expandConnectedEnvironmentCustomDomain(env.CustomDomain)
+
+			if metadata.ResourceData.HasChange("dapr_application_insights_connection_string") {
+				payload.Properties.DaprAIConnectionString = pointer.To(env.DaprAIConnectionString)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(env.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandConnectedEnvironmentCustomDomain(input []ContainerAppConnectedEnvironmentDomain) *connectedenvironments.CustomDomainConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	domain := input[0]
+	return &connectedenvironments.CustomDomainConfiguration{
+		DnsSuffix:           pointer.To(domain.DnsSuffix),
+		CertificateValue:    pointer.To(domain.CertificateBlob),
+		CertificatePassword: pointer.To(domain.CertificatePassword),
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppConnectedEnvironmentResource struct{}
+
+func TestAccContainerAppConnectedEnvironment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ContainerAppConnectedEnvironmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ContainerAppConnectedEnvironmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ContainerAppConnectedEnvironmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("dapr_application_insights_connection_string", "custom_domain.0.certificate_blob_base64", "custom_domain.0.certificate_password"),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ContainerAppConnectedEnvironmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("dapr_application_insights_connection_string", "custom_domain.0.certificate_blob_base64", "custom_domain.0.certificate_password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppConnectedEnvironmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectedenvironments.ParseConnectedEnvironmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ConnectedEnvironmentClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppConnectedEnvironmentResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_LOCATION_ID` was not specified")
+	}
+}
+
+func (r ContainerAppConnectedEnvironmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_connected_environment" "test" {
+  name                = "acctest-CACE%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r ContainerAppConnectedEnvironmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_connected_environment" "import" {
+  name                = azurerm_container_app_connected_environment.test.name
+  resource_group_name = azurerm_container_app_connected_environment.test.resource_group_name
+  location            = azurerm_container_app_connected_environment.test.location
+  custom_location_id  = azurerm_container_app_connected_environment.test.custom_location_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppConnectedEnvironmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_container_app_connected_environment" "test" {
+  name                = "acctest-CACE%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+
+  dapr_application_insights_connection_string = azurerm_application_insights.test.connection_string
+
+  custom_domain {
+    dns_suffix              = "acctest-%[2]d.example.com"
+    certificate_blob_base64 = filebase64("testdata/testacc.pfx")
+    certificate_password    = "TestAcc"
+  }
+
+  tags = {
+    Foo = "Bar"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r ContainerAppConnectedEnvironmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CACE-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -27,12 +27,16 @@ type ContainerAppEnvironmentDaprComponentModel struct {
 	Version              string                 `tfschema:"version"`
 	IgnoreErrors         bool                   `tfschema:"ignore_errors"`
 	InitTimeout          string                 `tfschema:"init_timeout"`
-	Secrets              []helpers.DaprSecret   `tfschema:"secret"`
+	SecretStoreComponent string                 `tfschema:"secret_store_component"`
+	Secrets              []helpers.Secret       `tfschema:"secret"`
 	Scopes               []string               `tfschema:"scopes"`
 	Metadata             []helpers.DaprMetadata `tfschema:"metadata"`
 }
 
-var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ContainerAppEnvironmentDaprComponentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentDaprComponentResource{}
+)
 
 func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentDaprComponentModel{}
@@ -96,6 +100,13 @@ func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pl
 
 		"secret": helpers.SecretsSchema(),
 
+		"secret_store_component": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.DaprComponentName,
+			Description:  "The name of a Dapr Secret Store Component used to resolve the `secret_name` of each `metadata` item, instead of the `secret` blocks.",
+		},
+
 		"metadata": helpers.ContainerAppEnvironmentDaprMetadataSchema(),
 
 		"scopes": {
@@ -103,7 +114,8 @@ func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pl
 			Optional: true,
 			MinItems: 1,
 			Elem: &pluginsdk.Schema{
-				Type: pluginsdk.TypeString,
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.DaprAppId,
 			},
 			Description: "A list of scopes to which this component applies. e.g. a Container App's `dapr.app_id` value.",
 		},
@@ -151,12 +163,16 @@ func (r ContainerAppEnvironmentDaprComponentResource) Create() sdk.ResourceFunc
 					IgnoreErrors:  pointer.To(daprComponent.IgnoreErrors),
 					InitTimeout:   pointer.To(daprComponent.InitTimeout),
 					Metadata:      expandDaprComponentPropertiesMetadata(daprComponent.Metadata),
-					Secrets:       helpers.ExpandDaprSecrets(daprComponent.Secrets),
+					Secrets:       helpers.ExpandDaprComponentSecrets(daprComponent.Secrets),
 					Scopes:        pointer.To(daprComponent.Scopes),
 					Version:       pointer.To(daprComponent.Version),
 				},
 			}
 
+			if daprComponent.SecretStoreComponent != "" {
+				daprComponentRequest.Properties.SecretStoreComponent = pointer.To(daprComponent.SecretStoreComponent)
+			}
+
 			if len(daprComponent.Scopes) > 0 {
 				daprComponentRequest.Properties.Scopes = &daprComponent.Scopes
 			}
@@ -202,6 +218,7 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 					state.InitTimeout = pointer.From(props.InitTimeout)
 					state.IgnoreErrors = pointer.From(props.IgnoreErrors)
 					state.Metadata = flattenDaprComponentPropertiesMetadata(props.Metadata)
+					state.SecretStoreComponent = pointer.From(props.SecretStoreComponent)
 				}
 			}
 
@@ -210,7 +227,11 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
 			}
 
-			state.Secrets = helpers.FlattenContainerAppDaprSecrets(secretsResp.Model)
+			var existingSecrets *[]daprcomponents.Secret
+			if model := daprComponentResp.Model; model != nil && model.Properties != nil {
+				existingSecrets = model.Properties.Secrets
+			}
+			state.Secrets = helpers.FlattenDaprComponentSecrets(helpers.UnpackDaprComponentSecrets(existingSecrets, secretsResp.Model))
 
 			return metadata.Encode(&state)
 		},
@@ -262,7 +283,7 @@ func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
 			}
 
-			existing.Model.Properties.Secrets = helpers.UnpackContainerDaprSecretsCollection(secretsResp.Model)
+			existing.Model.Properties.Secrets = helpers.UnpackDaprComponentSecrets(existing.Model.Properties.Secrets, secretsResp.Model)
 
 			if metadata.ResourceData.HasChange("version") {
 				existing.Model.Properties.Version = pointer.To(state.Version)
@@ -277,7 +298,14 @@ func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc
 			}
 
 			if metadata.ResourceData.HasChange("secret") {
-				existing.Model.Properties.Secrets = helpers.ExpandDaprSecrets(state.Secrets)
+				existing.Model.Properties.Secrets = helpers.ExpandDaprComponentSecrets(state.Secrets)
+			}
+
+			if metadata.ResourceData.HasChange("secret_store_component") {
+				existing.Model.Properties.SecretStoreComponent = nil
+				if state.SecretStoreComponent != "" {
+					existing.Model.Properties.SecretStoreComponent = pointer.To(state.SecretStoreComponent)
+				}
 			}
 
 			if metadata.ResourceData.HasChange("metadata") {
@@ -297,6 +325,69 @@ func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc
 	}
 }
 
+func (r ContainerAppEnvironmentDaprComponentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			scopes := make(map[string]bool)
+			for _, v := range rd.Get("scopes").([]interface{}) {
+				scope, _ := v.(string)
+				if scope == "" {
+					continue
+				}
+				if scopes[scope] {
+					return fmt.Errorf("the scope %q is specified more than once in `scopes`", scope)
+				}
+				scopes[scope] = true
+			}
+
+			// the values of secrets may not be known until apply, e.g. when referencing the key of another resource
+			if !rd.NewValueKnown("secret") || !rd.NewValueKnown("metadata") {
+				return nil
+			}
+
+			secretNames := make(map[string]bool)
+			for _, v := range rd.Get("secret").(*pluginsdk.Set).List() {
+				secret := v.(map[string]interface{})
+				name := secret["name"].(string)
+				keyVaultSecretId := secret["key_vault_secret_id"].(string)
+				value := secret["value"].(string)
+				identity := secret["identity"].(string)
+
+				if (keyVaultSecretId == "") == (value == "") {
+					return fmt.Errorf("exactly one of `value` or `key_vault_secret_id` must be specified for the secret %q", name)
+				}
+				if identity != "" && keyVaultSecretId == "" {
+					return fmt.Errorf("`identity` can only be specified for the secret %q when `key_vault_secret_id` is set", name)
+				}
+				if keyVaultSecretId != "" && identity == "" {
+					return fmt.Errorf("`identity` must be specified for the secret %q when `key_vault_secret_id` is set", name)
+				}
+				secretNames[name] = true
+			}
+
+			// secret references are resolved by the secret store component when one is specified
+			if rd.Get("secret_store_component").(string) != "" {
+				return nil
+			}
+
+			for _, v := range rd.Get("metadata").([]interface{}) {
+				item, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if secretName := item["secret_name"].(string); secretName != "" && !secretNames[secretName] {
+					return fmt.Errorf("the metadata %q references the secret %q which is not specified in a `secret` block", item["name"].(string), secretName)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func expandDaprComponentPropertiesMetadata(input []helpers.DaprMetadata) *[]daprcomponents.DaprMetadata {
 	if len(input) == 0 {
 		return nil
@@ -86,6 +86,37 @@ func TestAccContainerAppEnvironmentDaprComponent_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppEnvironmentDaprComponent_keyVaultSecret(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultSecret(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentDaprComponent_secretStoreComponent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.secretStoreComponent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret_store_component").HasValue("secretstore"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ContainerAppEnvironmentDaprComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := daprcomponents.ParseDaprComponentID(state.ID)
 	if err != nil {
@@ -265,6 +296,121 @@ resource "azurerm_container_app_environment_dapr_component" "test" {
 `, r.template(data), data.RandomInteger, data.RandomString)
 }
 
+func (r ContainerAppEnvironmentDaprComponentResource) keyVaultSecret(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy    = true
+      recover_soft_deleted_key_vaults = true
+    }
+  }
+}
+
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acct-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctest-kv-%[3]s"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+    secret_permissions = [
+      "Set",
+      "Get",
+      "Delete",
+      "Purge",
+      "Recover"
+    ]
+  }
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = [
+      "Get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%[3]s"
+  value        = "test-secret"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_container_app_environment_dapr_component" "test" {
+  name                         = "acctest-dapr-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "state.azure.blobstorage"
+  version                      = "v1"
+
+  secret {
+    name                = "storage-account-access-key"
+    identity            = azurerm_user_assigned_identity.test.id
+    key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+  }
+
+  metadata {
+    name        = "storage-account-key"
+    secret_name = "storage-account-access-key"
+  }
+
+  scopes = ["testapp"]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) secretStoreComponent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_dapr_component" "secretstore" {
+  name                         = "secretstore"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "secretstores.azure.keyvault"
+  version                      = "v1"
+
+  metadata {
+    name  = "vaultName"
+    value = "acctest-kv-%[3]s"
+  }
+}
+
+resource "azurerm_container_app_environment_dapr_component" "test" {
+  name                         = "acctest-dapr-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "state.azure.blobstorage"
+  version                      = "v1"
+  secret_store_component       = azurerm_container_app_environment_dapr_component.secretstore.name
+
+  metadata {
+    name        = "storage-account-key"
+    secret_name = "storage-account-access-key"
+  }
+
+  scopes = ["testapp"]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
 func (r ContainerAppEnvironmentDaprComponentResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
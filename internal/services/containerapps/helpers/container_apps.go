@@ -2692,17 +2692,40 @@ func UnpackContainerSecretsCollection(input *containerapps.SecretsCollection) *[
 	return &result
 }
 
-func UnpackContainerDaprSecretsCollection(input *daprcomponents.DaprSecretsCollection) *[]daprcomponents.Secret {
-	if input == nil || len(input.Value) == 0 {
-		return nil
+// UnpackDaprComponentSecrets rebuilds the secrets of an existing Dapr Component, since the values are only returned by
+// the ListSecrets API and the Key Vault references are only returned by the Get API
+func UnpackDaprComponentSecrets(existing *[]daprcomponents.Secret, values *daprcomponents.DaprSecretsCollection) *[]daprcomponents.Secret {
+	secretValues := make(map[string]*string)
+	if values != nil {
+		for _, v := range values.Value {
+			secretValues[pointer.From(v.Name)] = v.Value
+		}
 	}
 
 	result := make([]daprcomponents.Secret, 0)
-	for _, v := range input.Value {
-		result = append(result, daprcomponents.Secret{
-			Name:  v.Name,
-			Value: v.Value,
-		})
+	if existing != nil && len(*existing) > 0 {
+		for _, v := range *existing {
+			secret := daprcomponents.Secret{
+				Identity:    v.Identity,
+				KeyVaultUrl: v.KeyVaultUrl,
+				Name:        v.Name,
+			}
+			if pointer.From(v.KeyVaultUrl) == "" {
+				secret.Value = secretValues[pointer.From(v.Name)]
+			}
+			result = append(result, secret)
+		}
+	} else if values != nil {
+		for _, v := range values.Value {
+			result = append(result, daprcomponents.Secret{
+				Name:  v.Name,
+				Value: v.Value,
+			})
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
 	}
 
 	return &result
@@ -2762,7 +2785,7 @@ func DaprSecretsDataSourceSchema() *pluginsdk.Schema {
 	}
 }
 
-func ExpandDaprSecrets(input []DaprSecret) *[]daprcomponents.Secret {
+func ExpandDaprComponentSecrets(input []Secret) *[]daprcomponents.Secret {
 	if len(input) == 0 {
 		return nil
 	}
@@ -2770,15 +2793,20 @@ func ExpandDaprSecrets(input []DaprSecret) *[]daprcomponents.Secret {
 	result := make([]daprcomponents.Secret, 0)
 
 	for _, v := range input {
-		result = append(result, daprcomponents.Secret{
-			Name:  pointer.To(v.Name),
-			Value: pointer.To(v.Value),
-		})
+		secret := daprcomponents.Secret{
+			Name: pointer.To(v.Name),
+		}
+		if v.KeyVaultSecretId != "" {
+			secret.KeyVaultUrl = pointer.To(v.KeyVaultSecretId)
+			secret.Identity = pointer.To(v.Identity)
+		} else {
+			secret.Value = pointer.To(v.Value)
+		}
+		result = append(result, secret)
 	}
 
 	return &result
 }
-
 func FlattenContainerAppSecrets(input *containerapps.SecretsCollection) []Secret {
 	if input == nil || input.Value == nil {
 		return []Secret{}
@@ -2799,21 +2827,23 @@ func FlattenContainerAppSecrets(input *containerapps.SecretsCollection) []Secret
 	return result
 }
 
-func FlattenContainerAppDaprSecrets(input *daprcomponents.DaprSecretsCollection) []DaprSecret {
-	if input == nil || input.Value == nil {
-		return []DaprSecret{}
+func FlattenDaprComponentSecrets(input *[]daprcomponents.Secret) []Secret {
+	if input == nil {
+		return []Secret{}
 	}
-	result := make([]DaprSecret, 0)
-	for _, v := range input.Value {
-		result = append(result, DaprSecret{
-			Name:  pointer.From(v.Name),
-			Value: pointer.From(v.Value),
+
+	result := make([]Secret, 0)
+	for _, v := range *input {
+		result = append(result, Secret{
+			Identity:         pointer.From(v.Identity),
+			KeyVaultSecretId: pointer.From(v.KeyVaultUrl),
+			Name:             pointer.From(v.Name),
+			Value:            pointer.From(v.Value),
 		})
 	}
 
 	return result
 }
-
 func ContainerAppProbesRemoved(metadata sdk.ResourceMetaData) bool {
 	var hasLiveness, hasReadiness, hasStartup bool
 
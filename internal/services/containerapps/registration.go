@@ -35,6 +35,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContainerAppConnectedEnvironmentCertificateResource{},
+		ContainerAppConnectedEnvironmentDaprComponentResource{},
+		ContainerAppConnectedEnvironmentResource{},
 		ContainerAppEnvironmentCertificateResource{},
 		ContainerAppEnvironmentCustomDomainResource{},
 		ContainerAppEnvironmentDaprComponentResource{},
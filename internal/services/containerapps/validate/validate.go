@@ -39,6 +39,20 @@ func DaprComponentName(i interface{}, k string) (warnings []string, errors []err
 	return
 }
 
+func DaprAppId(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-z][a-z0-9-]{0,58}[a-z0-9]?$`).Match([]byte(v)); !matched || strings.HasSuffix(v, "-") || strings.Contains(v, "--") {
+		errors = append(errors, fmt.Errorf("%q must be a valid Dapr App ID, consisting of lower case alphanumeric characters or '-', starting with an alphabetic character, ending with an alphanumeric character and not containing '--'. The length must not be more than 60 characters", k))
+	}
+
+	return
+}
+
 func SecretName(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
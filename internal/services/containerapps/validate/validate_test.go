@@ -65,6 +65,63 @@ func TestValidateDaprComponentName(t *testing.T) {
 	}
 }
 
+func TestValidateDaprAppId(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "1app",
+			Valid: false,
+		},
+		{
+			Input: "my-app",
+			Valid: true,
+		},
+		{
+			Input: "my-app-",
+			Valid: false,
+		},
+		{
+			Input: "my--app",
+			Valid: false,
+		},
+		{
+			Input: "MyApp",
+			Valid: false,
+		},
+		{
+			Input: "my.app",
+			Valid: false,
+		},
+		{
+			Input: "a23456789012345678901234567890123456789012345678901234567890",
+			Valid: true,
+		},
+		{
+			Input: "a234567890123456789012345678901234567890123456789012345678901",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := DaprAppId(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s", tc.Valid, valid, tc.Input)
+		}
+	}
+}
+
 func TestValidateSecretNames(t *testing.T) {
 	cases := []struct {
 		Input string
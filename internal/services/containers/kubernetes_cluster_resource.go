@@ -124,6 +124,34 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("custom_ca_trust_certificates_base64", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			// Node Auto-Provisioning can't be disabled once it's been enabled
+			pluginsdk.ForceNewIfChange("node_provisioning_profile.0.mode", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(managedclusters.NodeProvisioningModeAuto) && new.(string) != string(managedclusters.NodeProvisioningModeAuto)
+			}),
+			// Istio is upgraded by adding the new revision alongside the current one (a canary upgrade), then removing the
+			// old revision once the workloads have been migrated - so the revisions can't be replaced in a single step
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.HasChange("service_mesh_profile.0.revisions") {
+					return nil
+				}
+
+				old, new := d.GetChange("service_mesh_profile.0.revisions")
+				oldRevisions := old.([]interface{})
+				newRevisions := new.([]interface{})
+				if len(oldRevisions) == 0 || len(newRevisions) == 0 {
+					return nil
+				}
+
+				for _, oldRevision := range oldRevisions {
+					for _, newRevision := range newRevisions {
+						if oldRevision.(string) == newRevision.(string) {
+							return nil
+						}
+					}
+				}
+
+				return fmt.Errorf("the Istio revisions can't be replaced in a single step - to perform a canary upgrade add the new revision to `service_mesh_profile.0.revisions` alongside the current revision %q, then remove the current revision once the upgrade has completed", oldRevisions[0].(string))
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -1213,6 +1241,22 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"node_provisioning_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForNodeProvisioningMode(), false),
+						},
+					},
+				},
+			},
+
 			"node_resource_group": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1311,6 +1355,17 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
 						},
+						"revisions": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
 					},
 				},
 			},
@@ -1947,6 +2002,8 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		securityProfile.CustomCATrustCertificates = convertCustomCaTrustCertsInput(customCaTrustCertListRaw)
 	}
 
+	nodeProvisioningProfile := expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+
 	parameters := managedclusters.ManagedCluster{
 		ExtendedLocation: expandEdgeZone(d.Get("edge_zone").(string)),
 		Location:         location,
@@ -1969,6 +2026,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			WindowsProfile:            windowsProfile,
 			MetricsProfile:            metricsProfile,
 			NetworkProfile:            networkProfile,
+			NodeProvisioningProfile:   nodeProvisioningProfile,
 			NodeResourceGroup:         utils.String(nodeResourceGroup),
 			DisableLocalAccounts:      utils.Bool(d.Get("local_account_disabled").(bool)),
 			HTTPProxyConfig:           httpProxyConfig,
@@ -2514,6 +2572,11 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.Model.Properties.SecurityProfile.Defender = microsoftDefender
 	}
 
+	if d.HasChange("node_provisioning_profile") {
+		updateCluster = true
+		existing.Model.Properties.NodeProvisioningProfile = expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+	}
+
 	if d.HasChanges("storage_profile") {
 		updateCluster = true
 		storageProfileRaw := d.Get("storage_profile").([]interface{})
@@ -3027,6 +3090,10 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `windows_profile`: %+v", err)
 			}
 
+			if err := d.Set("node_provisioning_profile", flattenKubernetesClusterNodeProvisioningProfile(props.NodeProvisioningProfile)); err != nil {
+				return fmt.Errorf("setting `node_provisioning_profile`: %+v", err)
+			}
+
 			workloadAutoscalerProfile := flattenKubernetesClusterWorkloadAutoscalerProfile(props.WorkloadAutoScalerProfile)
 			if err := d.Set("workload_autoscaler_profile", workloadAutoscalerProfile); err != nil {
 				return fmt.Errorf("setting `workload_autoscaler_profile`: %+v", err)
@@ -3401,6 +3468,29 @@ func expandKubernetesClusterWorkloadAutoscalerProfile(input []interface{}, d *pl
 	return &workloadAutoscalerProfile
 }
 
+func expandKubernetesClusterNodeProvisioningProfile(input []interface{}) *managedclusters.ManagedClusterNodeProvisioningProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	config := input[0].(map[string]interface{})
+	return &managedclusters.ManagedClusterNodeProvisioningProfile{
+		Mode: pointer.To(managedclusters.NodeProvisioningMode(config["mode"].(string))),
+	}
+}
+
+func flattenKubernetesClusterNodeProvisioningProfile(input *managedclusters.ManagedClusterNodeProvisioningProfile) []interface{} {
+	if input == nil || input.Mode == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(*input.Mode),
+		},
+	}
+}
+
 func expandGmsaProfile(input []interface{}) *managedclusters.WindowsGmsaProfile {
 	if len(input) == 0 {
 		return nil
@@ -4813,6 +4903,13 @@ func expandKubernetesClusterServiceMeshProfile(input []interface{}, existing *ma
 		}
 
 		profile.Istio.Components.IngressGateways = &istioIngressGatewaysList
+
+		if revisions := utils.ExpandStringSlice(raw["revisions"].([]interface{})); len(*revisions) > 0 {
+			profile.Istio.Revisions = revisions
+		} else if existing != nil && existing.Istio != nil {
+			// retain the revisions which have been installed when these aren't specified
+			profile.Istio.Revisions = existing.Istio.Revisions
+		}
 	}
 
 	return &profile
@@ -4938,6 +5035,8 @@ func flattenKubernetesClusterAzureServiceMeshProfile(input *managedclusters.Serv
 		}
 	}
 
+	returnMap["revisions"] = utils.FlattenStringSlice(input.Istio.Revisions)
+
 	return []interface{}{returnMap}
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -56,6 +57,10 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *managedcluste
 		}
 	}
 
+	if err := validateKubernetesClusterNodeProvisioningProfile(d); err != nil {
+		return err
+	}
+
 	// @tombuildsstuff: As of 2020-03-30 it's no longer possible to create a cluster using a Service Principal
 	// for authentication (albeit this worked on 2020-03-27 via API version 2019-10-01 :shrug:). However it's
 	// possible to rotate the Service Principal for an existing Cluster - so this needs to be supported via
@@ -267,6 +272,34 @@ details can be found at https://aka.ms/version-skew-policy.
 `, desiredNodePoolVersion, nodePoolName, clusterName, resourceGroup, clusterVersionDetails, versionsList)
 }
 
+// validateKubernetesClusterNodeProvisioningProfile ensures the requirements for Node Auto-Provisioning are met, which
+// scales the cluster by provisioning nodes itself - and as such can't be used alongside the Cluster Autoscaler
+func validateKubernetesClusterNodeProvisioningProfile(d *pluginsdk.ResourceData) error {
+	if d.Get("node_provisioning_profile.0.mode").(string) != string(managedclusters.NodeProvisioningModeAuto) {
+		return nil
+	}
+
+	autoScalingKey := "default_node_pool.0.enable_auto_scaling"
+	if features.FourPointOhBeta() {
+		autoScalingKey = "default_node_pool.0.auto_scaling_enabled"
+	}
+	if d.Get(autoScalingKey).(bool) {
+		return fmt.Errorf("`%s` must be disabled when `node_provisioning_profile.0.mode` is set to `%s`", strings.TrimPrefix(autoScalingKey, "default_node_pool.0."), managedclusters.NodeProvisioningModeAuto)
+	}
+
+	networkPlugin := d.Get("network_profile.0.network_plugin").(string)
+	networkPluginMode := d.Get("network_profile.0.network_plugin_mode").(string)
+	networkDataPlane := d.Get("network_profile.0.network_data_plane").(string)
+	if !features.FourPointOhBeta() && networkDataPlane != string(managedclusters.NetworkDataplaneCilium) {
+		networkDataPlane = d.Get("network_profile.0.ebpf_data_plane").(string)
+	}
+	if !strings.EqualFold(networkPlugin, string(managedclusters.NetworkPluginAzure)) || !strings.EqualFold(networkPluginMode, string(managedclusters.NetworkPluginModeOverlay)) || !strings.EqualFold(networkDataPlane, string(managedclusters.NetworkDataplaneCilium)) {
+		return fmt.Errorf("`node_provisioning_profile.0.mode` can only be set to `%s` when the `network_profile` uses the `azure` `network_plugin` with the `overlay` `network_plugin_mode` and the `cilium` network data plane", managedclusters.NodeProvisioningModeAuto)
+	}
+
+	return nil
+}
+
 func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client, currentNodePoolVersion string, defaultNodePoolId agentpools.AgentPoolId, desiredNodePoolVersion string) error {
 	// confirm the version being used is >= the version of the control plane
 	clusterId := commonids.NewKubernetesClusterID(defaultNodePoolId.SubscriptionId, defaultNodePoolId.ResourceGroupName, defaultNodePoolId.ManagedClusterName)
@@ -33,6 +33,8 @@ type KubernetesFleetUpdateRunResourceSchema struct {
 	ManagedClusterUpdate     []KubernetesFleetUpdateRunResourceManagedClusterUpdateSchema `tfschema:"managed_cluster_update"`
 	FleetUpdateStrategyId    string                                                       `tfschema:"fleet_update_strategy_id"`
 	Stage                    []KubernetesFleetUpdateRunResourceUpdateStageSchema          `tfschema:"stage"`
+	StartOnCreateEnabled     bool                                                         `tfschema:"start_on_create_enabled"`
+	State                    string                                                       `tfschema:"state"`
 }
 
 type KubernetesFleetUpdateRunResourceManagedClusterUpdateSchema struct {
@@ -168,16 +170,29 @@ func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Sche
 				},
 			},
 		},
+
+		"start_on_create_enabled": {
+			Optional: true,
+			ForceNew: true,
+			Type:     pluginsdk.TypeBool,
+			Default:  false,
+		},
 	}
 }
 
 func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Computed: true,
+			Type:     pluginsdk.TypeString,
+		},
+	}
 }
 
 func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
-		Timeout: 30 * time.Minute,
+		// the Update Run can optionally be started and awaited, which can take some time when there are many members
+		Timeout: 3 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Containers.FleetUpdateRunsClient
 
@@ -216,6 +231,17 @@ func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if config.StartOnCreateEnabled {
+				if err := client.StartThenPoll(ctx, id, updateruns.DefaultStartOperationOptions()); err != nil {
+					return fmt.Errorf("starting %s: %+v", id, err)
+				}
+
+				if err := waitForKubernetesFleetUpdateRunToComplete(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -272,7 +298,15 @@ func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Containers.FleetUpdateRunsClient
-			schema := KubernetesFleetUpdateRunResourceSchema{}
+
+			// `start_on_create_enabled` isn't returned by the API, so this is retained from the state
+			var existing KubernetesFleetUpdateRunResourceSchema
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			schema := KubernetesFleetUpdateRunResourceSchema{
+				StartOnCreateEnabled: existing.StartOnCreateEnabled,
+			}
 
 			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
 			if err != nil {
@@ -294,6 +328,7 @@ func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
 					schema.ManagedClusterUpdate = flattenKubernetesFleetUpdateRunManagedClusterUpdate(model.Properties.ManagedClusterUpdate)
 					schema.FleetUpdateStrategyId = pointer.From(model.Properties.UpdateStrategyId)
 					schema.Stage = flattenKubernetesFleetUpdateRunStage(model.Properties.Strategy.Stages)
+					schema.State = kubernetesFleetUpdateRunState(model.Properties.Status)
 				}
 			}
 
@@ -313,6 +348,17 @@ func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			// an Update Run which is in progress has to be stopped before it can be deleted
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && kubernetesFleetUpdateRunState(existing.Model.Properties.Status) == string(updateruns.UpdateStateRunning) {
+				if err := client.StopThenPoll(ctx, *id, updateruns.DefaultStopOperationOptions()); err != nil {
+					return fmt.Errorf("stopping %s: %+v", *id, err)
+				}
+			}
+
 			if err := client.DeleteThenPoll(ctx, *id, updateruns.DefaultDeleteOperationOptions()); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
@@ -434,3 +480,51 @@ func flattenKubernetesFleetUpdateRunGroup(input *[]updateruns.UpdateGroup) []Kub
 	}
 	return output
 }
+
+func kubernetesFleetUpdateRunState(input *updateruns.UpdateRunStatus) string {
+	if input == nil || input.Status == nil {
+		return ""
+	}
+	return string(pointer.From(input.Status.State))
+}
+
+func waitForKubernetesFleetUpdateRunToComplete(ctx context.Context, client *updateruns.UpdateRunsClient, id updateruns.UpdateRunId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(updateruns.UpdateStateNotStarted),
+			string(updateruns.UpdateStateRunning),
+		},
+		Target: []string{
+			string(updateruns.UpdateStateCompleted),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			status := resp.Model.Properties.Status
+			state := kubernetesFleetUpdateRunState(status)
+			if state == string(updateruns.UpdateStateFailed) && status.Status.Error != nil {
+				return resp, state, fmt.Errorf("%s failed: %s", id, pointer.From(status.Status.Error.Message))
+			}
+			return resp, state, nil
+		},
+		MinTimeout:   30 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
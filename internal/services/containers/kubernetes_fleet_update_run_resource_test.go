@@ -91,6 +91,22 @@ func TestAccKubernetesFleetUpdateRun_update(t *testing.T) {
 		data.ImportStep(),
 	})
 }
+func TestAccKubernetesFleetUpdateRun_startOnCreate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.startOnCreate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Completed"),
+			),
+		},
+		data.ImportStep("start_on_create_enabled"),
+	})
+}
+
 func (r KubernetesFleetUpdateRunTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := updateruns.ParseUpdateRunID(state.ID)
 	if err != nil {
@@ -126,6 +142,29 @@ resource "azurerm_kubernetes_fleet_update_run" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r KubernetesFleetUpdateRunTestResource) startOnCreate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "test" {
+  name                        = "acctestfus-%[2]d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+  }
+  fleet_update_strategy_id = azurerm_kubernetes_fleet_update_strategy.test.id
+  start_on_create_enabled  = true
+
+  lifecycle {
+    ignore_changes = [stage]
+  }
+  depends_on = [azurerm_kubernetes_fleet_member.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r KubernetesFleetUpdateRunTestResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesNodePoolSnapshotResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	SourceNodePoolId  string            `tfschema:"source_node_pool_id"`
+	Tags              map[string]string `tfschema:"tags"`
+	KubernetesVersion string            `tfschema:"kubernetes_version"`
+	NodeImageVersion  string            `tfschema:"node_image_version"`
+	VMSize            string            `tfschema:"vm_size"`
+}
+
+type KubernetesNodePoolSnapshotResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesNodePoolSnapshotResource{}
+
+func (r KubernetesNodePoolSnapshotResource) ResourceType() string {
+	return "azurerm_kubernetes_node_pool_snapshot"
+}
+
+func (r KubernetesNodePoolSnapshotResource) ModelObject() interface{} {
+	return &KubernetesNodePoolSnapshotResourceModel{}
+}
+
+func (r KubernetesNodePoolSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r KubernetesNodePoolSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"source_node_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: agentpools.ValidateAgentPoolID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesNodePoolSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"node_image_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vm_size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesNodePoolSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config KubernetesNodePoolSnapshotResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := snapshots.NewSnapshotID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			nodePoolId, err := agentpools.ParseAgentPoolID(config.SourceNodePoolId)
+			if err != nil {
+				return err
+			}
+
+			payload := snapshots.Snapshot{
+				Location: location.Normalize(config.Location),
+				Properties: &snapshots.SnapshotProperties{
+					CreationData: &snapshots.CreationData{
+						SourceResourceId: pointer.To(nodePoolId.ID()),
+					},
+					SnapshotType: pointer.To(snapshots.SnapshotTypeNodePool),
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesNodePoolSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesNodePoolSnapshotResourceModel{
+				Name:              id.SnapshotName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.KubernetesVersion = pointer.From(props.KubernetesVersion)
+					state.NodeImageVersion = pointer.From(props.NodeImageVersion)
+					state.VMSize = pointer.From(props.VMSize)
+
+					if props.CreationData != nil && props.CreationData.SourceResourceId != nil {
+						nodePoolId, err := agentpools.ParseAgentPoolIDInsensitively(*props.CreationData.SourceResourceId)
+						if err != nil {
+							return err
+						}
+						state.SourceNodePoolId = nodePoolId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesNodePoolSnapshotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesNodePoolSnapshotResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := snapshots.TagsObject{
+					Tags: pointer.To(config.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesNodePoolSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -79,6 +79,7 @@ func (r Registration) Resources() []sdk.Resource {
 		KubernetesFleetManagerResource{},
 		KubernetesFleetUpdateRunResource{},
 		KubernetesFleetUpdateStrategyResource{},
+		KubernetesNodePoolSnapshotResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
@@ -795,6 +795,14 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -1470,6 +1478,20 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	// all keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if d.HasChange("rotation_triggers") {
+		log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Regenerating Keys")
+
+		for _, keyKind := range []cosmosdb.KeyKind{cosmosdb.KeyKindPrimary, cosmosdb.KeyKindSecondary, cosmosdb.KeyKindPrimaryReadonly, cosmosdb.KeyKindSecondaryReadonly} {
+			parameters := cosmosdb.DatabaseAccountRegenerateKeyParameters{
+				KeyKind: keyKind,
+			}
+			if err := client.DatabaseAccountsRegenerateKeyThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("regenerating the %q key for %s: %+v", keyKind, *id, err)
+			}
+		}
+	}
+
 	return resourceCosmosDbAccountRead(d, meta)
 }
 
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/cosmosdb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceCosmosDbSQLContainerPartitionMetrics() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbSQLContainerPartitionMetricsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"container_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: cosmosdb.ValidateContainerID,
+			},
+
+			"partition": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"partition_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"partition_key_range_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"storage_in_bytes": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"document_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"total_storage_in_bytes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"max_partition_storage_in_bytes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"storage_skew_ratio": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmosDbSQLContainerPartitionMetricsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CosmosDBClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := cosmosdb.ParseContainerID(d.Get("container_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the partition usages are exposed through the (legacy) `databases/collections` path
+	collectionId := cosmosdb.NewCollectionID(id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	resp, err := client.CollectionPartitionListUsages(ctx, collectionId, cosmosdb.DefaultCollectionPartitionListUsagesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving partition usages for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("container_id", id.ID())
+
+	var usages []cosmosdb.PartitionUsage
+	if model := resp.Model; model != nil && model.Value != nil {
+		usages = *model.Value
+	}
+
+	partitions, totalStorage, maxStorage := flattenCosmosDbSQLContainerPartitionUsages(usages)
+	if err := d.Set("partition", partitions); err != nil {
+		return fmt.Errorf("setting `partition`: %+v", err)
+	}
+	d.Set("total_storage_in_bytes", totalStorage)
+	d.Set("max_partition_storage_in_bytes", maxStorage)
+
+	// the skew is the ratio between the largest partition and the average partition, where `1` is an even distribution
+	skew := 0.0
+	if totalStorage > 0 && len(partitions) > 0 {
+		skew = float64(maxStorage) / (float64(totalStorage) / float64(len(partitions)))
+	}
+	d.Set("storage_skew_ratio", skew)
+
+	return nil
+}
+
+func flattenCosmosDbSQLContainerPartitionUsages(input []cosmosdb.PartitionUsage) (partitions []interface{}, totalStorage int64, maxStorage int64) {
+	type partitionUsage struct {
+		partitionKeyRangeId string
+		storage             int64
+		documentCount       int64
+	}
+
+	// the usages are returned per metric, so these are grouped by partition
+	usagesByPartition := make(map[string]*partitionUsage)
+	for _, item := range input {
+		partitionId := pointer.From(item.PartitionId)
+		usage, ok := usagesByPartition[partitionId]
+		if !ok {
+			usage = &partitionUsage{
+				partitionKeyRangeId: pointer.From(item.PartitionKeyRangeId),
+			}
+			usagesByPartition[partitionId] = usage
+		}
+
+		switch pointer.From(item.Unit) {
+		case cosmosdb.UnitTypeBytes:
+			usage.storage += pointer.From(item.CurrentValue)
+		case cosmosdb.UnitTypeCount:
+			usage.documentCount += pointer.From(item.CurrentValue)
+		}
+	}
+
+	partitionIds := make([]string, 0, len(usagesByPartition))
+	for partitionId := range usagesByPartition {
+		partitionIds = append(partitionIds, partitionId)
+	}
+	sort.Strings(partitionIds)
+
+	partitions = make([]interface{}, 0, len(partitionIds))
+	for _, partitionId := range partitionIds {
+		usage := usagesByPartition[partitionId]
+
+		totalStorage += usage.storage
+		if usage.storage > maxStorage {
+			maxStorage = usage.storage
+		}
+
+		partitions = append(partitions, map[string]interface{}{
+			"partition_id":           partitionId,
+			"partition_key_range_id": usage.partitionKeyRangeId,
+			"storage_in_bytes":       int(usage.storage),
+			"document_count":         int(usage.documentCount),
+		})
+	}
+
+	return partitions, totalStorage, maxStorage
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CosmosDBSqlContainerPartitionMetricsDataSource struct{}
+
+func TestAccDataSourceCosmosDBSqlContainerPartitionMetrics_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_sql_container_partition_metrics", "test")
+	r := CosmosDBSqlContainerPartitionMetricsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("container_id").Exists(),
+				check.That(data.ResourceName).Key("total_storage_in_bytes").Exists(),
+				check.That(data.ResourceName).Key("max_partition_storage_in_bytes").Exists(),
+				check.That(data.ResourceName).Key("storage_skew_ratio").Exists(),
+			),
+		},
+	})
+}
+
+func (CosmosDBSqlContainerPartitionMetricsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_sql_container_partition_metrics" "test" {
+  container_id = azurerm_cosmosdb_sql_container.test.id
+}
+`, CosmosSqlContainerResource{}.basic(data))
+}
@@ -81,9 +81,10 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				Optional: !features.FourPointOhBeta(),
 				Computed: !features.FourPointOhBeta(),
 				ForceNew: true,
+				MaxItems: 3,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validate.CosmosPartitionKeyPath,
 				},
 				ExactlyOneOf: func() []string {
 					if !features.FourPointOhBeta() {
@@ -122,9 +123,12 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 
+			// analytical storage can't be disabled once enabled, so removing the value from the configuration keeps
+			// the current value rather than recreating the container - which requires explicitly setting it to `0`
 			"analytical_storage_ttl": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
 
@@ -160,6 +164,7 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("analytical_storage_ttl", func(ctx context.Context, old, new, _ interface{}) bool {
 				return (old.(int) == -1 || old.(int) > 0) && new.(int) == 0
 			}),
+			pluginsdk.CustomizeDiffShim(cosmosDbSQLContainerPartitionKeyCustomizeDiff),
 		),
 	}
 
@@ -464,6 +469,41 @@ func resourceCosmosDbSQLContainerDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
+func cosmosDbSQLContainerPartitionKeyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("partition_key_paths") || !d.NewValueKnown("partition_key_kind") || !d.NewValueKnown("partition_key_version") {
+		return nil
+	}
+
+	paths := d.Get("partition_key_paths").([]interface{})
+	if len(paths) == 0 {
+		return nil
+	}
+
+	switch cosmosdb.PartitionKind(d.Get("partition_key_kind").(string)) {
+	case cosmosdb.PartitionKindHash:
+		if len(paths) > 1 {
+			return fmt.Errorf("only one path can be specified in `partition_key_paths` when `partition_key_kind` is `%s`, use `%s` for hierarchical partition keys", cosmosdb.PartitionKindHash, cosmosdb.PartitionKindMultiHash)
+		}
+
+	case cosmosdb.PartitionKindMultiHash:
+		// hierarchical partition keys are only supported with the large partition key (version 2)
+		if d.Get("partition_key_version").(int) != 2 {
+			return fmt.Errorf("`partition_key_version` must be set to `2` when `partition_key_kind` is `%s`", cosmosdb.PartitionKindMultiHash)
+		}
+	}
+
+	seen := make(map[string]struct{})
+	for _, v := range paths {
+		path, _ := v.(string)
+		if _, ok := seen[path]; ok {
+			return fmt.Errorf("the path %q is specified more than once in `partition_key_paths`", path)
+		}
+		seen[path] = struct{}{}
+	}
+
+	return nil
+}
+
 func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]cosmosdb.UniqueKey {
 	i := s.List()
 	if len(i) == 0 || i[0] == nil {
@@ -6,6 +6,7 @@ package cosmos_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/cosmosdb"
@@ -245,6 +246,61 @@ func TestAccCosmosDbSqlContainer_hierarchicalPartitionKeys(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_analyticalStorageTTLUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.analyticalStorageTTLValue(data, 600),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analytical_storage_ttl").HasValue("600"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.analyticalStorageTTLValue(data, -1),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analytical_storage_ttl").HasValue("-1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.analyticalStorageTTLValue(data, 0),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbSqlContainer_hierarchicalPartitionKeysInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.hierarchicalPartitionKeysInvalid(data, "Hash", `["/definition", "/id"]`, 2),
+			ExpectError: regexp.MustCompile("only one path can be specified in `partition_key_paths`"),
+		},
+		{
+			Config:      r.hierarchicalPartitionKeysInvalid(data, "MultiHash", `["/definition", "/id"]`, 1),
+			ExpectError: regexp.MustCompile("`partition_key_version` must be set to `2`"),
+		},
+		{
+			Config:      r.hierarchicalPartitionKeysInvalid(data, "MultiHash", `["/definition", "/id", "/sessionId", "/userId"]`, 2),
+			ExpectError: regexp.MustCompile("Too many list items"),
+		},
+		{
+			Config:      r.hierarchicalPartitionKeysInvalid(data, "MultiHash", `["/definition", "id/"]`, 2),
+			ExpectError: regexp.MustCompile("must be a path starting with"),
+		},
+	})
+}
+
 func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := cosmosdb.ParseContainerID(state.ID)
 	if err != nil {
@@ -633,3 +689,40 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 }
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
 }
+
+func (CosmosSqlContainerResource) analyticalStorageTTLValue(data acceptance.TestData, ttl int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                   = "acctest-CSQLC-%[2]d"
+  resource_group_name    = azurerm_cosmosdb_account.test.resource_group_name
+  account_name           = azurerm_cosmosdb_account.test.name
+  database_name          = azurerm_cosmosdb_sql_database.test.name
+  partition_key_paths    = ["/definition/id"]
+  analytical_storage_ttl = %[3]d
+}
+`, CosmosDBAccountResource{}.analyticalStorage(data, "GlobalDocumentDB", "Eventual", true), data.RandomInteger, ttl)
+}
+
+func (CosmosSqlContainerResource) hierarchicalPartitionKeysInvalid(data acceptance.TestData, kind, paths string, version int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                  = "acctest-CSQLC-%[2]d"
+  resource_group_name   = azurerm_cosmosdb_account.test.resource_group_name
+  account_name          = azurerm_cosmosdb_account.test.name
+  database_name         = azurerm_cosmosdb_sql_database.test.name
+  partition_key_kind    = "%[3]s"
+  partition_key_paths   = %[4]s
+  partition_key_version = %[5]d
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger, kind, paths, version)
+}
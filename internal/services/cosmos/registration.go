@@ -52,11 +52,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_cosmosdb_account":                      dataSourceCosmosDbAccount(),
-		"azurerm_cosmosdb_mongo_database":               dataSourceCosmosDbMongoDatabase(),
-		"azurerm_cosmosdb_restorable_database_accounts": dataSourceCosmosDbRestorableDatabaseAccounts(),
-		"azurerm_cosmosdb_sql_database":                 dataSourceCosmosDbSQLDatabase(),
-		"azurerm_cosmosdb_sql_role_definition":          dataSourceCosmosDbSQLRoleDefinition(),
+		"azurerm_cosmosdb_account":                         dataSourceCosmosDbAccount(),
+		"azurerm_cosmosdb_mongo_database":                  dataSourceCosmosDbMongoDatabase(),
+		"azurerm_cosmosdb_restorable_database_accounts":    dataSourceCosmosDbRestorableDatabaseAccounts(),
+		"azurerm_cosmosdb_sql_container_partition_metrics": dataSourceCosmosDbSQLContainerPartitionMetrics(),
+		"azurerm_cosmosdb_sql_database":                    dataSourceCosmosDbSQLDatabase(),
+		"azurerm_cosmosdb_sql_role_definition":             dataSourceCosmosDbSQLRoleDefinition(),
 	}
 }
 
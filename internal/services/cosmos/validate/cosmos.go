@@ -65,3 +65,18 @@ func CosmosMaxThroughput(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func CosmosPartitionKeyPath(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// e.g. `/tenantId` or `/address/zipCode`
+	if !regexp.MustCompile(`^(/[^/]+)+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a path starting with `/` and without empty or trailing segments, e.g. `/tenantId` or `/address/zipCode`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -152,3 +152,46 @@ func TestCosmosMaxThroughput(t *testing.T) {
 		}
 	}
 }
+
+func TestCosmosPartitionKeyPath(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "tenantId",
+			Errors: 1,
+		},
+		{
+			Value:  "/",
+			Errors: 1,
+		},
+		{
+			Value:  "/tenantId",
+			Errors: 0,
+		},
+		{
+			Value:  "/tenantId/",
+			Errors: 1,
+		},
+		{
+			Value:  "/address//zipCode",
+			Errors: 1,
+		},
+		{
+			Value:  "/address/zipCode",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CosmosPartitionKeyPath(tc.Value, "partition_key_paths")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected CosmosPartitionKeyPath to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
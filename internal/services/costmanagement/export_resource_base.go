@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2021-10-01/exports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -107,6 +107,12 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 				},
 			},
 		},
+
+		"partition_data_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 
 	for k, v := range fields {
@@ -185,6 +191,8 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 						metadata.ResourceData.Set("recurrence_type", string(pointer.From(schedule.Recurrence)))
 					}
 
+					metadata.ResourceData.Set("partition_data_enabled", pointer.From(props.PartitionData))
+
 					exportDeliveryInfo, err := flattenExportDataStorageLocation(&props.DeliveryInfo)
 					if err != nil {
 						return fmt.Errorf("flattening `export_data_storage_location`: %+v", err)
@@ -280,9 +288,10 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 				},
 				Status: &status,
 			},
-			DeliveryInfo: *deliveryInfo,
-			Format:       &format,
-			Definition:   *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			DeliveryInfo:  *deliveryInfo,
+			Format:        &format,
+			PartitionData: pointer.To(metadata.ResourceData.Get("partition_data_enabled").(bool)),
+			Definition:    *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
 		},
 	}
 
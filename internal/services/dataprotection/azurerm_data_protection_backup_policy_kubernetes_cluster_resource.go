@@ -43,8 +43,14 @@ type RetentionRule struct {
 }
 
 type LifeCycle struct {
-	DataStoreType string `tfschema:"data_store_type"`
-	Duration      string `tfschema:"duration"`
+	DataStoreType     string              `tfschema:"data_store_type"`
+	Duration          string              `tfschema:"duration"`
+	TargetCopySetting []TargetCopySetting `tfschema:"target_copy_setting"`
+}
+
+type TargetCopySetting struct {
+	CopyOption string `tfschema:"copy_option"`
+	Duration   string `tfschema:"duration"`
 }
 
 type Criteria struct {
@@ -55,6 +61,12 @@ type Criteria struct {
 	WeeksOfMonth         []string `tfschema:"weeks_of_month"`
 }
 
+const (
+	backupPolicyCopyOptionCopyOnExpiry = "CopyOnExpiryOption"
+	backupPolicyCopyOptionCustom       = "CustomCopyOption"
+	backupPolicyCopyOptionImmediate    = "ImmediateCopyOption"
+)
+
 type DataProtectionBackupPolicyKubernatesClusterResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupPolicyKubernatesClusterResource{}
@@ -119,9 +131,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									Required: true,
 									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
-										// confirmed with the service team that current possible value only support `OperationalStore`.
-										// However, considering that `VaultStore` might be supported in the future, it would be exposed for user specification.
 										string(backuppolicies.DataStoreTypesOperationalStore),
+										string(backuppolicies.DataStoreTypesVaultStore),
 									}, false),
 								},
 
@@ -131,6 +142,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									ForceNew:     true,
 									ValidateFunc: validate.ISO8601Duration,
 								},
+
+								"target_copy_setting": backupPolicyKubernetesClusterTargetCopySettingSchema(),
 							},
 						},
 					},
@@ -223,9 +236,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									Required: true,
 									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
-										// confirmed with the service team that currently only `OperationalStore` is supported.
-										// However, since `VaultStore` is in public preview and will be supported in the future, it is open to user specification.
 										string(backuppolicies.DataStoreTypesOperationalStore),
+										string(backuppolicies.DataStoreTypesVaultStore),
 									}, false),
 								},
 
@@ -235,6 +247,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									ForceNew:     true,
 									ValidateFunc: validate.ISO8601Duration,
 								},
+
+								"target_copy_setting": backupPolicyKubernetesClusterTargetCopySettingSchema(),
 							},
 						},
 					},
@@ -258,6 +272,36 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 	return arguments
 }
 
+func backupPolicyKubernetesClusterTargetCopySettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"copy_option": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						backupPolicyCopyOptionCopyOnExpiry,
+						backupPolicyCopyOptionCustom,
+						backupPolicyCopyOptionImmediate,
+					}, false),
+				},
+
+				"duration": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
 func (r DataProtectionBackupPolicyKubernatesClusterResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -435,7 +479,7 @@ func expandBackupPolicyKubernetesClusterLifeCycle(input []LifeCycle) []backuppol
 				DataStoreType: backuppolicies.DataStoreTypes(item.DataStoreType),
 				ObjectType:    "DataStoreInfoBase",
 			},
-			TargetDataStoreCopySettings: &[]backuppolicies.TargetCopySetting{},
+			TargetDataStoreCopySettings: expandBackupPolicyKubernetesClusterTargetCopySettings(item.TargetCopySetting),
 		}
 		results = append(results, sourceLifeCycle)
 	}
@@ -443,6 +487,34 @@ func expandBackupPolicyKubernetesClusterLifeCycle(input []LifeCycle) []backuppol
 	return results
 }
 
+func expandBackupPolicyKubernetesClusterTargetCopySettings(input []TargetCopySetting) *[]backuppolicies.TargetCopySetting {
+	results := make([]backuppolicies.TargetCopySetting, 0)
+	for _, item := range input {
+		var copyOption backuppolicies.CopyOption
+		switch item.CopyOption {
+		case backupPolicyCopyOptionCopyOnExpiry:
+			copyOption = backuppolicies.CopyOnExpiryOption{}
+		case backupPolicyCopyOptionCustom:
+			copyOption = backuppolicies.CustomCopyOption{
+				Duration: pointer.To(item.Duration),
+			}
+		case backupPolicyCopyOptionImmediate:
+			copyOption = backuppolicies.ImmediateCopyOption{}
+		}
+
+		// the copy is always taken from the operational tier into the vault tier
+		results = append(results, backuppolicies.TargetCopySetting{
+			CopyAfter: copyOption,
+			DataStore: backuppolicies.DataStoreInfoBase{
+				DataStoreType: backuppolicies.DataStoreTypesVaultStore,
+				ObjectType:    "DataStoreInfoBase",
+			},
+		})
+	}
+
+	return &results
+}
+
 func expandBackupPolicyKubernetesClusterTaggingCriteriaArray(input []RetentionRule) (*[]backuppolicies.TaggingCriteria, error) {
 	results := []backuppolicies.TaggingCriteria{
 		{
@@ -691,8 +763,35 @@ func flattenBackupPolicyKubernetesClusterBackupLifeCycleArray(input []backuppoli
 		dataStoreType = string(item.SourceDataStore.DataStoreType)
 
 		results = append(results, LifeCycle{
-			Duration:      duration,
-			DataStoreType: dataStoreType,
+			Duration:          duration,
+			DataStoreType:     dataStoreType,
+			TargetCopySetting: flattenBackupPolicyKubernetesClusterTargetCopySettings(item.TargetDataStoreCopySettings),
+		})
+	}
+	return results
+}
+
+func flattenBackupPolicyKubernetesClusterTargetCopySettings(input *[]backuppolicies.TargetCopySetting) []TargetCopySetting {
+	results := make([]TargetCopySetting, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var copyOption, duration string
+		switch v := item.CopyAfter.(type) {
+		case backuppolicies.CopyOnExpiryOption:
+			copyOption = backupPolicyCopyOptionCopyOnExpiry
+		case backuppolicies.CustomCopyOption:
+			copyOption = backupPolicyCopyOptionCustom
+			duration = pointer.From(v.Duration)
+		case backuppolicies.ImmediateCopyOption:
+			copyOption = backupPolicyCopyOptionImmediate
+		}
+
+		results = append(results, TargetCopySetting{
+			CopyOption: copyOption,
+			Duration:   duration,
 		})
 	}
 	return results
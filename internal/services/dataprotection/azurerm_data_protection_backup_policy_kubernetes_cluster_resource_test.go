@@ -61,6 +61,20 @@ func TestAccDataProtectionBackupPolicyKubernatesCluster_complete(t *testing.T) {
 	})
 }
 
+func TestAccDataProtectionBackupPolicyKubernatesCluster_vaultTier(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_policy_kubernetes_cluster", "test")
+	r := DataProtectionBackupPolicyKubernatesClusterTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vaultTier(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r DataProtectionBackupPolicyKubernatesClusterTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := backuppolicies.ParseBackupPolicyID(state.ID)
 	if err != nil {
@@ -214,3 +228,43 @@ resource "azurerm_data_protection_backup_policy_kubernetes_cluster" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r DataProtectionBackupPolicyKubernatesClusterTestResource) vaultTier(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_protection_backup_policy_kubernetes_cluster" "test" {
+  name                            = "acctest-aks-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  vault_name                      = azurerm_data_protection_backup_vault.test.name
+  backup_repeating_time_intervals = ["R/2021-05-23T02:30:00+00:00/P1D"]
+
+  retention_rule {
+    name     = "Daily"
+    priority = 25
+
+    life_cycle {
+      duration        = "P30D"
+      data_store_type = "VaultStore"
+    }
+
+    criteria {
+      absolute_criteria = "FirstOfDay"
+    }
+  }
+
+  default_retention_rule {
+    life_cycle {
+      duration        = "P7D"
+      data_store_type = "OperationalStore"
+
+      target_copy_setting {
+        copy_option = "CustomCopyOption"
+        duration    = "P1D"
+      }
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
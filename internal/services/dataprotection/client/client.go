@@ -9,15 +9,17 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/dppresourceguardproxies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	BackupVaultClient    *backupvaults.BackupVaultsClient
-	BackupPolicyClient   *backuppolicies.BackupPoliciesClient
-	BackupInstanceClient *backupinstances.BackupInstancesClient
-	ResourceGuardClient  *resourceguards.ResourceGuardsClient
+	BackupVaultClient        *backupvaults.BackupVaultsClient
+	BackupPolicyClient       *backuppolicies.BackupPoliciesClient
+	BackupInstanceClient     *backupinstances.BackupInstancesClient
+	ResourceGuardClient      *resourceguards.ResourceGuardsClient
+	ResourceGuardProxyClient *dppresourceguardproxies.DppResourceGuardProxiesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,10 +47,17 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceGuardClient.Client, o.Authorizers.ResourceManager)
 
+	resourceGuardProxyClient, err := dppresourceguardproxies.NewDppResourceGuardProxiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DppResourceGuardProxies client: %+v", err)
+	}
+	o.Configure(resourceGuardProxyClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		BackupVaultClient:    backupVaultClient,
-		BackupPolicyClient:   backupPolicyClient,
-		BackupInstanceClient: backupInstanceClient,
-		ResourceGuardClient:  resourceGuardClient,
+		BackupVaultClient:        backupVaultClient,
+		BackupPolicyClient:       backupPolicyClient,
+		BackupInstanceClient:     backupInstanceClient,
+		ResourceGuardClient:      resourceGuardClient,
+		ResourceGuardProxyClient: resourceGuardProxyClient,
 	}, nil
 }
@@ -6,6 +6,7 @@ package dataprotection
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -13,34 +14,46 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-03-02-preview/trustedaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type BackupInstanceKubernatesClusterModel struct {
-	Name                       string                       `tfschema:"name"`
-	Location                   string                       `tfschema:"location"`
-	VaultId                    string                       `tfschema:"vault_id"`
-	BackupPolicyId             string                       `tfschema:"backup_policy_id"`
-	KubernetesClusterId        string                       `tfschema:"kubernetes_cluster_id"`
-	SnapshotResourceGroupName  string                       `tfschema:"snapshot_resource_group_name"`
-	BackupDatasourceParameters []BackupDatasourceParameters `tfschema:"backup_datasource_parameters"`
+	Name                            string                       `tfschema:"name"`
+	Location                        string                       `tfschema:"location"`
+	VaultId                         string                       `tfschema:"vault_id"`
+	BackupPolicyId                  string                       `tfschema:"backup_policy_id"`
+	KubernetesClusterId             string                       `tfschema:"kubernetes_cluster_id"`
+	SnapshotResourceGroupName       string                       `tfschema:"snapshot_resource_group_name"`
+	BackupDatasourceParameters      []BackupDatasourceParameters `tfschema:"backup_datasource_parameters"`
+	TrustedAccessRoleBindingEnabled bool                         `tfschema:"trusted_access_role_binding_enabled"`
 }
 
 type BackupDatasourceParameters struct {
-	IncludedNamespaces          []string `tfschema:"included_namespaces"`
-	IncludedResourceTypes       []string `tfschema:"included_resource_types"`
-	ExcludedNamespaces          []string `tfschema:"excluded_namespaces"`
-	ExcludedResourceTypes       []string `tfschema:"excluded_resource_types"`
-	LabelSelectors              []string `tfschema:"label_selectors"`
-	VolumeSnapshotEnabled       bool     `tfschema:"volume_snapshot_enabled"`
-	ClusterScopeResourceEnabled bool     `tfschema:"cluster_scoped_resources_enabled"`
+	IncludedNamespaces          []string              `tfschema:"included_namespaces"`
+	IncludedResourceTypes       []string              `tfschema:"included_resource_types"`
+	ExcludedNamespaces          []string              `tfschema:"excluded_namespaces"`
+	ExcludedResourceTypes       []string              `tfschema:"excluded_resource_types"`
+	LabelSelectors              []string              `tfschema:"label_selectors"`
+	VolumeSnapshotEnabled       bool                  `tfschema:"volume_snapshot_enabled"`
+	ClusterScopeResourceEnabled bool                  `tfschema:"cluster_scoped_resources_enabled"`
+	BackupHookReference         []BackupHookReference `tfschema:"backup_hook_reference"`
 }
 
+type BackupHookReference struct {
+	Name      string `tfschema:"name"`
+	Namespace string `tfschema:"namespace"`
+}
+
+// backupInstanceKubernetesClusterTrustedAccessRole is the role which the Backup Vault requires on the Kubernetes Cluster
+const backupInstanceKubernetesClusterTrustedAccessRole = "Microsoft.DataProtection/backupVaults/backup-operator"
+
 type DataProtectionBackupInstanceKubernatesClusterResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstanceKubernatesClusterResource{}
@@ -97,6 +110,27 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Arguments() map[s
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
+					"backup_hook_reference": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"namespace": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
 					"excluded_namespaces": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -152,6 +186,13 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Arguments() map[s
 				},
 			},
 		},
+
+		"trusted_access_role_binding_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
 	}
 }
 
@@ -197,6 +238,12 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Create() sdk.Reso
 				return err
 			}
 
+			if model.TrustedAccessRoleBindingEnabled {
+				if err := createBackupInstanceKubernetesClusterTrustedAccess(ctx, metadata, *aksId, *vaultId); err != nil {
+					return err
+				}
+			}
+
 			snapshotResourceGroupId := resourceParse.NewResourceGroupID(metadata.Client.Account.SubscriptionId, model.SnapshotResourceGroupName)
 			parameters := backupinstances.BackupInstanceResource{
 				Properties: &backupinstances.BackupInstance{
@@ -267,9 +314,16 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Read() sdk.Resour
 
 			vaultId := backupinstances.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName)
 
+			// the Trusted Access Role Binding isn't part of the Backup Instance, so this is retained from the state
+			var existing BackupInstanceKubernatesClusterModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			state := BackupInstanceKubernatesClusterModel{
-				Name:    id.BackupInstanceName,
-				VaultId: vaultId.ID(),
+				Name:                            id.BackupInstanceName,
+				VaultId:                         vaultId.ID(),
+				TrustedAccessRoleBindingEnabled: existing.TrustedAccessRoleBindingEnabled,
 			}
 
 			if model := resp.Model; model != nil {
@@ -315,11 +369,28 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Delete() sdk.Reso
 				return err
 			}
 
+			var model BackupInstanceKubernatesClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			err = client.DeleteThenPoll(ctx, *id, backupinstances.DefaultDeleteOperationOptions())
 			if err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
+			if model.TrustedAccessRoleBindingEnabled {
+				aksId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+				if err != nil {
+					return err
+				}
+
+				vaultId := backupinstances.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName)
+				if err := deleteBackupInstanceKubernetesClusterTrustedAccess(ctx, metadata, *aksId, vaultId); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -338,10 +409,26 @@ func expandBackupDatasourceParameters(input []BackupDatasourceParameters) *[]bac
 		IncludedResourceTypes:        pointer.To(input[0].IncludedResourceTypes),
 		LabelSelectors:               pointer.To(input[0].LabelSelectors),
 		SnapshotVolumes:              input[0].VolumeSnapshotEnabled,
+		BackupHookReferences:         expandBackupInstanceKubernetesClusterBackupHookReferences(input[0].BackupHookReference),
 	})
 	return &results
 }
 
+func expandBackupInstanceKubernetesClusterBackupHookReferences(input []BackupHookReference) *[]backupinstances.NamespacedNameResource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]backupinstances.NamespacedNameResource, 0)
+	for _, item := range input {
+		results = append(results, backupinstances.NamespacedNameResource{
+			Name:      pointer.To(item.Name),
+			Namespace: pointer.To(item.Namespace),
+		})
+	}
+	return &results
+}
+
 func flattenBackupDatasourceParameters(input []backupinstances.BackupDatasourceParameters) *[]BackupDatasourceParameters {
 	results := make([]BackupDatasourceParameters, 0)
 	if len(input) == 0 {
@@ -357,7 +444,90 @@ func flattenBackupDatasourceParameters(input []backupinstances.BackupDatasourceP
 			IncludedResourceTypes:       pointer.From(item.IncludedResourceTypes),
 			LabelSelectors:              pointer.From(item.LabelSelectors),
 			VolumeSnapshotEnabled:       item.SnapshotVolumes,
+			BackupHookReference:         flattenBackupInstanceKubernetesClusterBackupHookReferences(item.BackupHookReferences),
 		})
 	}
 	return &results
 }
+
+func flattenBackupInstanceKubernetesClusterBackupHookReferences(input *[]backupinstances.NamespacedNameResource) []BackupHookReference {
+	results := make([]BackupHookReference, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, BackupHookReference{
+			Name:      pointer.From(item.Name),
+			Namespace: pointer.From(item.Namespace),
+		})
+	}
+	return results
+}
+
+// backupInstanceKubernetesClusterTrustedAccessRoleBindingName returns the name of the Trusted Access Role Binding
+// used by the Backup Vault, which is limited to 24 characters
+func backupInstanceKubernetesClusterTrustedAccessRoleBindingName(vaultId backupinstances.BackupVaultId) string {
+	name := vaultId.BackupVaultName
+	if len(name) > 24 {
+		name = name[0:24]
+	}
+	return name
+}
+
+func createBackupInstanceKubernetesClusterTrustedAccess(ctx context.Context, metadata sdk.ResourceMetaData, aksId commonids.KubernetesClusterId, vaultId backupinstances.BackupVaultId) error {
+	client := metadata.Client.ContainerService.V20230302Preview.TrustedAccess
+
+	// the Backup Vault may already have been granted access to this Kubernetes Cluster, in which case there's nothing to do
+	bindings, err := client.RoleBindingsListComplete(ctx, aksId)
+	if err != nil {
+		return fmt.Errorf("listing Trusted Access Role Bindings for %s: %+v", aksId, err)
+	}
+	for _, item := range bindings.Items {
+		if !strings.EqualFold(item.Properties.SourceResourceId, vaultId.ID()) {
+			continue
+		}
+		for _, role := range item.Properties.Roles {
+			if strings.EqualFold(role, backupInstanceKubernetesClusterTrustedAccessRole) {
+				return nil
+			}
+		}
+	}
+
+	id := trustedaccess.NewTrustedAccessRoleBindingID(aksId.SubscriptionId, aksId.ResourceGroupName, aksId.ManagedClusterName, backupInstanceKubernetesClusterTrustedAccessRoleBindingName(vaultId))
+	payload := trustedaccess.TrustedAccessRoleBinding{
+		Properties: trustedaccess.TrustedAccessRoleBindingProperties{
+			Roles:            []string{backupInstanceKubernetesClusterTrustedAccessRole},
+			SourceResourceId: vaultId.ID(),
+		},
+	}
+	if _, err := client.RoleBindingsCreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func deleteBackupInstanceKubernetesClusterTrustedAccess(ctx context.Context, metadata sdk.ResourceMetaData, aksId commonids.KubernetesClusterId, vaultId backupinstances.BackupVaultId) error {
+	client := metadata.Client.ContainerService.V20230302Preview.TrustedAccess
+
+	id := trustedaccess.NewTrustedAccessRoleBindingID(aksId.SubscriptionId, aksId.ResourceGroupName, aksId.ManagedClusterName, backupInstanceKubernetesClusterTrustedAccessRoleBindingName(vaultId))
+
+	// only remove the Role Binding when it's the one which was created for this Backup Vault
+	existing, err := client.RoleBindingsGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := existing.Model; model == nil || !strings.EqualFold(model.Properties.SourceResourceId, vaultId.ID()) {
+		return nil
+	}
+
+	if _, err := client.RoleBindingsDelete(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
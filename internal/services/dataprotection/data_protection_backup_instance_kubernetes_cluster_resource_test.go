@@ -61,6 +61,20 @@ func TestAccDataProtectionBackupInstanceKubernatesCluster_complete(t *testing.T)
 	})
 }
 
+func TestAccDataProtectionBackupInstanceKubernatesCluster_trustedAccessRoleBinding(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_instance_kubernetes_cluster", "test")
+	r := DataProtectionBackupInstanceKubernatesClusterTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.trustedAccessRoleBinding(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("trusted_access_role_binding_enabled"),
+	})
+}
+
 func (r DataProtectionBackupInstanceKubernatesClusterTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := backupinstances.ParseBackupInstanceID(state.ID)
 	if err != nil {
@@ -316,3 +330,30 @@ resource "azurerm_data_protection_backup_instance_kubernetes_cluster" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r DataProtectionBackupInstanceKubernatesClusterTestResource) trustedAccessRoleBinding(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_protection_backup_instance_kubernetes_cluster" "test" {
+  name                                = "acctest-iaks-%[2]d"
+  location                            = azurerm_resource_group.test.location
+  vault_id                            = azurerm_data_protection_backup_vault.test.id
+  backup_policy_id                    = azurerm_data_protection_backup_policy_kubernetes_cluster.test.id
+  kubernetes_cluster_id               = azurerm_kubernetes_cluster.test.id
+  snapshot_resource_group_name        = azurerm_resource_group.snap.name
+  trusted_access_role_binding_enabled = true
+
+  depends_on = [
+    azurerm_role_assignment.test_extension_and_storage_account_permission,
+    azurerm_role_assignment.test_vault_msi_read_on_cluster,
+    azurerm_role_assignment.test_vault_msi_read_on_snap_rg,
+    azurerm_role_assignment.test_cluster_msi_contributor_on_snap_rg,
+    azurerm_role_assignment.test_vault_msi_snapshot_contributor_on_snap_rg,
+    azurerm_role_assignment.test_vault_data_operator_on_snap_rg,
+    azurerm_role_assignment.test_vault_data_contributor_on_storage,
+  ]
+}
+`, template, data.RandomInteger)
+}
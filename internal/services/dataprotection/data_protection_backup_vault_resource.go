@@ -71,6 +71,11 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"cross_region_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"identity": commonschema.SystemAssignedIdentityOptional(),
 
 			"retention_duration_in_days": {
@@ -94,6 +99,16 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("soft_delete", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) == string(backupvaults.SoftDeleteStateAlwaysOn) && new.(string) != string(backupvaults.SoftDeleteStateAlwaysOn)
 			}),
+			// Cross Region Restore can't be disabled once it's been enabled
+			pluginsdk.ForceNewIfChange("cross_region_restore_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Get("cross_region_restore_enabled").(bool) && diff.Get("redundancy").(string) != string(backupvaults.StorageSettingTypesGeoRedundant) {
+					return fmt.Errorf("`cross_region_restore_enabled` can only be set when `redundancy` is `%s`", string(backupvaults.StorageSettingTypesGeoRedundant))
+				}
+				return nil
+			},
 		),
 	}
 
@@ -175,6 +190,14 @@ func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, me
 		Tags:     expandTags(d.Get("tags").(map[string]interface{})),
 	}
 
+	if d.Get("cross_region_restore_enabled").(bool) {
+		parameters.Properties.FeatureSettings = &backupvaults.FeatureSettings{
+			CrossRegionRestoreSettings: &backupvaults.CrossRegionRestoreSettings{
+				State: pointer.To(backupvaults.CrossRegionRestoreStateEnabled),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("retention_duration_in_days"); ok {
 		parameters.Properties.SecuritySettings.SoftDeleteSettings.RetentionDurationInDays = pointer.To(v.(float64))
 	}
@@ -224,6 +247,14 @@ func resourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta inter
 			}
 		}
 
+		crossRegionRestoreEnabled := false
+		if featureSetting := model.Properties.FeatureSettings; featureSetting != nil {
+			if crossRegionRestore := featureSetting.CrossRegionRestoreSettings; crossRegionRestore != nil {
+				crossRegionRestoreEnabled = pointer.From(crossRegionRestore.State) == backupvaults.CrossRegionRestoreStateEnabled
+			}
+		}
+		d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+
 		if err = d.Set("identity", flattenBackupVaultDppIdentityDetails(model.Identity)); err != nil {
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
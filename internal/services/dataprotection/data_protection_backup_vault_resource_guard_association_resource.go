@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/dppresourceguardproxies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the service only supports a single Resource Guard Proxy per Backup Vault, which must have this name
+const dataProtectionBackupVaultResourceGuardProxyName = "DppResourceGuardProxy"
+
+type DataProtectionBackupVaultResourceGuardAssociationModel struct {
+	VaultId         string `tfschema:"vault_id"`
+	ResourceGuardId string `tfschema:"resource_guard_id"`
+}
+
+type DataProtectionBackupVaultResourceGuardAssociationResource struct{}
+
+var _ sdk.Resource = DataProtectionBackupVaultResourceGuardAssociationResource{}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) ResourceType() string {
+	return "azurerm_data_protection_backup_vault_resource_guard_association"
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) ModelObject() interface{} {
+	return &DataProtectionBackupVaultResourceGuardAssociationModel{}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dppresourceguardproxies.ValidateBackupResourceGuardProxyID
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"vault_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: backupvaults.ValidateBackupVaultID,
+		},
+
+		"resource_guard_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceguards.ValidateResourceGuardID,
+		},
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			var model DataProtectionBackupVaultResourceGuardAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			vaultId, err := backupvaults.ParseBackupVaultID(model.VaultId)
+			if err != nil {
+				return err
+			}
+
+			resourceGuardId, err := resourceguards.ParseResourceGuardID(model.ResourceGuardId)
+			if err != nil {
+				return err
+			}
+
+			id := dppresourceguardproxies.NewBackupResourceGuardProxyID(vaultId.SubscriptionId, vaultId.ResourceGroupName, vaultId.BackupVaultName, dataProtectionBackupVaultResourceGuardProxyName)
+
+			existing, err := client.DppResourceGuardProxyGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := dppresourceguardproxies.ResourceGuardProxyBaseResource{
+				Properties: &dppresourceguardproxies.ResourceGuardProxyBase{
+					ResourceGuardResourceId: pointer.To(resourceGuardId.ID()),
+				},
+			}
+
+			if _, err := client.DppResourceGuardProxyCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			id, err := dppresourceguardproxies.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.DppResourceGuardProxyGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DataProtectionBackupVaultResourceGuardAssociationModel{
+				VaultId: backupvaults.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.ResourceGuardResourceId != nil {
+					resourceGuardId, err := resourceguards.ParseResourceGuardIDInsensitively(*props.ResourceGuardResourceId)
+					if err != nil {
+						return err
+					}
+					state.ResourceGuardId = resourceGuardId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			id, err := dppresourceguardproxies.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DataProtectionBackupVaultResourceGuardAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceGuardId, err := resourceguards.ParseResourceGuardID(model.ResourceGuardId)
+			if err != nil {
+				return err
+			}
+
+			// removing the association is itself a critical operation, so this has to be unlocked first - which
+			// requires the caller to have the relevant permissions on the Resource Guard, unless it's been excluded
+			requestId := resourceguards.NewDeleteResourceGuardProxyRequestID(resourceGuardId.SubscriptionId, resourceGuardId.ResourceGroupName, resourceGuardId.ResourceGuardName, "default")
+			unlock := dppresourceguardproxies.UnlockDeleteRequest{
+				ResourceGuardOperationRequests: pointer.To([]string{requestId.ID()}),
+				ResourceToBeDeleted:            pointer.To(id.ID()),
+			}
+			if _, err := client.DppResourceGuardProxyUnlockDelete(ctx, *id, unlock, dppresourceguardproxies.DefaultDppResourceGuardProxyUnlockDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("unlocking the deletion of %s: %+v", *id, err)
+			}
+
+			if _, err := client.DppResourceGuardProxyDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
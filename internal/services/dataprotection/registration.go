@@ -62,5 +62,6 @@ func (r Registration) Resources() []sdk.Resource {
 		DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{},
 		DataProtectionBackupInstanceKubernatesClusterResource{},
 		DataProtectionBackupInstancePostgreSQLFlexibleServerResource{},
+		DataProtectionBackupVaultResourceGuardAssociationResource{},
 	}
 }
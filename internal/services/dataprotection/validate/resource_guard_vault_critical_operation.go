@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceGuardVaultCriticalOperation validates an operation which can be excluded from the protection of a Resource
+// Guard, e.g. `Microsoft.RecoveryServices/vaults/backupconfig/write` or `Microsoft.DataProtection/backupVaults/backupInstances/delete`
+func ResourceGuardVaultCriticalOperation(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^(Microsoft\.RecoveryServices/vaults|Microsoft\.DataProtection/backupVaults)(/[a-zA-Z]+)+/(write|delete|action)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be an operation on a Recovery Services Vault or a Data Protection Backup Vault, such as `Microsoft.RecoveryServices/vaults/backupconfig/write` or `Microsoft.DataProtection/backupVaults/backupInstances/delete`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
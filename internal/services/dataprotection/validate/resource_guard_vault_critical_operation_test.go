@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestResourceGuardVaultCriticalOperation(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "Microsoft.RecoveryServices/vaults",
+			expected: false,
+		},
+		{
+			input:    "Microsoft.RecoveryServices/vaults/backupconfig/write",
+			expected: true,
+		},
+		{
+			input:    "Microsoft.RecoveryServices/vaults/backupResourceGuardProxies/delete",
+			expected: true,
+		},
+		{
+			input:    "Microsoft.RecoveryServices/vaults/backupSecurityPIN/action",
+			expected: true,
+		},
+		{
+			input:    "Microsoft.DataProtection/backupVaults/backupInstances/delete",
+			expected: true,
+		},
+		{
+			input:    "Microsoft.DataProtection/backupVaults/backupInstances/read",
+			expected: false,
+		},
+		{
+			input:    "Microsoft.Storage/storageAccounts/write",
+			expected: false,
+		},
+		{
+			input:    "Microsoft.DataProtection/backupVaults/backupInstances/delete ",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ResourceGuardVaultCriticalOperation(v.input, "vault_critical_operation_exclusion_list")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationruleseventhubs"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -68,6 +68,14 @@ func resourceEventHubAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
@@ -124,6 +132,15 @@ func resourceEventHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, me
 			return pluginsdk.NonRetryableError(fmt.Errorf("Expected %s was not be found", id))
 		}
 
+		// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+		if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+			for _, keyType := range []authorizationruleseventhubs.KeyType{authorizationruleseventhubs.KeyTypePrimaryKey, authorizationruleseventhubs.KeyTypeSecondaryKey} {
+				if _, err := authorizationRulesClient.EventHubsRegenerateKeys(ctx, localId, authorizationruleseventhubs.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+					return pluginsdk.NonRetryableError(fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err))
+				}
+			}
+		}
+
 		d.SetId(id.ID())
 
 		if err := resourceEventHubAuthorizationRuleRead(d, meta); err != nil {
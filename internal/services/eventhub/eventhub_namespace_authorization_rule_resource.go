@@ -61,6 +61,14 @@ func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
@@ -103,6 +111,15 @@ func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resourc
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+		for _, keyType := range []authorizationrulesnamespaces.KeyType{authorizationrulesnamespaces.KeyTypePrimaryKey, authorizationrulesnamespaces.KeyTypeSecondaryKey} {
+			if _, err := client.NamespacesRegenerateKeys(ctx, id, authorizationrulesnamespaces.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+			}
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceEventHubNamespaceAuthorizationRuleRead(d, meta)
 }
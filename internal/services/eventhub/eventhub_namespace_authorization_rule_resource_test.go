@@ -172,6 +172,32 @@ func TestAccEventHubNamespaceAuthorizationRule_multi(t *testing.T) {
 	})
 }
 
+func TestAccEventHubNamespaceAuthorizationRule_rotationTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_authorization_rule", "test")
+	r := EventHubNamespaceAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTriggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+		{
+			Config: r.rotationTriggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+	})
+}
+
 func (EventHubNamespaceAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := authorizationrulesnamespaces.ParseAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -318,3 +344,38 @@ resource "azurerm_eventhub_namespace_authorization_rule" "test3" {
 }
 `, template, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (EventHubNamespaceAuthorizationRuleResource) rotationTriggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctest-EHN-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_authorization_rule" "test" {
+  name                = "acctest-EHN-AR%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = false
+
+  rotation_triggers = {
+    rotation = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, trigger)
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -137,6 +137,25 @@ func resourceEventHub() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: commonids.ValidateStorageAccountID,
 									},
+									"identity": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(eventhubs.PossibleValuesForCaptureIdentityType(), false),
+												},
+												"user_assigned_identity_id": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -198,7 +217,11 @@ func resourceEventHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	if _, ok := d.GetOk("capture_description"); ok {
-		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
+		captureDescription, err := expandEventHubCaptureDescription(d)
+		if err != nil {
+			return err
+		}
+		parameters.Properties.CaptureDescription = captureDescription
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
@@ -240,20 +263,21 @@ func resourceEventHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	captureDescription, err := expandEventHubCaptureDescription(d)
+	if err != nil {
+		return err
+	}
+
 	eventhubStatus := eventhubs.EntityStatus(d.Get("status").(string))
 	parameters := eventhubs.Eventhub{
 		Properties: &eventhubs.EventhubProperties{
 			PartitionCount:         utils.Int64(int64(d.Get("partition_count").(int))),
 			MessageRetentionInDays: utils.Int64(int64(d.Get("message_retention").(int))),
 			Status:                 &eventhubStatus,
-			CaptureDescription:     expandEventHubCaptureDescription(d),
+			CaptureDescription:     captureDescription,
 		},
 	}
 
-	if d.HasChange("capture_description") {
-		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
-	}
-
 	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return err
 	}
@@ -325,10 +349,10 @@ func resourceEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.CaptureDescription {
+func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) (*eventhubs.CaptureDescription, error) {
 	inputs := d.Get("capture_description").([]interface{})
 	if len(inputs) == 0 || inputs[0] == nil {
-		return nil
+		return nil, nil
 	}
 	input := inputs[0].(map[string]interface{})
 
@@ -359,8 +383,14 @@ func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.Capt
 			blobContainerName := destination["blob_container_name"].(string)
 			storageAccountId := destination["storage_account_id"].(string)
 
+			identity, err := expandEventHubCaptureIdentity(destination["identity"].([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+
 			captureDescription.Destination = &eventhubs.Destination{
-				Name: utils.String(destinationName),
+				Name:     utils.String(destinationName),
+				Identity: identity,
 				Properties: &eventhubs.DestinationProperties{
 					ArchiveNameFormat:        utils.String(archiveNameFormat),
 					BlobContainer:            utils.String(blobContainerName),
@@ -370,7 +400,32 @@ func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.Capt
 		}
 	}
 
-	return &captureDescription
+	return &captureDescription, nil
+}
+
+func expandEventHubCaptureIdentity(input []interface{}) (*eventhubs.CaptureIdentity, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	identityType := eventhubs.CaptureIdentityType(v["type"].(string))
+	userAssignedIdentityId := v["user_assigned_identity_id"].(string)
+	if identityType == eventhubs.CaptureIdentityTypeUserAssigned && userAssignedIdentityId == "" {
+		return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when the `type` of the capture `identity` is `%s`", eventhubs.CaptureIdentityTypeUserAssigned)
+	}
+	if identityType != eventhubs.CaptureIdentityTypeUserAssigned && userAssignedIdentityId != "" {
+		return nil, fmt.Errorf("`user_assigned_identity_id` can only be specified when the `type` of the capture `identity` is `%s`", eventhubs.CaptureIdentityTypeUserAssigned)
+	}
+
+	identity := &eventhubs.CaptureIdentity{
+		Type: &identityType,
+	}
+	if userAssignedIdentityId != "" {
+		identity.UserAssignedIdentity = utils.String(userAssignedIdentityId)
+	}
+
+	return identity, nil
 }
 
 func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription) []interface{} {
@@ -383,9 +438,12 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 			output["enabled"] = *enabled
 		}
 
-		if skipEmptyArchives := description.SkipEmptyArchives; skipEmptyArchives != nil {
-			output["skip_empty_archives"] = *skipEmptyArchives
+		// the API omits `skipEmptyArchives` when it's disabled
+		skipEmptyArchives := false
+		if description.SkipEmptyArchives != nil {
+			skipEmptyArchives = *description.SkipEmptyArchives
 		}
+		output["skip_empty_archives"] = skipEmptyArchives
 
 		encoding := ""
 		if description.Encoding != nil {
@@ -420,6 +478,8 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 				}
 			}
 
+			destinationOutput["identity"] = flattenEventHubCaptureIdentity(destination.Identity)
+
 			output["destination"] = []interface{}{destinationOutput}
 		}
 
@@ -428,3 +488,24 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 
 	return results
 }
+
+func flattenEventHubCaptureIdentity(input *eventhubs.CaptureIdentity) []interface{} {
+	if input == nil || input.Type == nil {
+		return make([]interface{}, 0)
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.UserAssignedIdentity)
+		if err == nil {
+			userAssignedIdentityId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(*input.Type),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lighthouse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LighthouseAssignmentsDataSource struct{}
+
+var _ sdk.DataSource = LighthouseAssignmentsDataSource{}
+
+type LighthouseAssignmentsDataSourceModel struct {
+	Scope       string                      `tfschema:"scope"`
+	Assignments []LighthouseAssignmentModel `tfschema:"assignment"`
+}
+
+type LighthouseAssignmentModel struct {
+	Id                     string                                 `tfschema:"id"`
+	Name                   string                                 `tfschema:"name"`
+	LighthouseDefinitionId string                                 `tfschema:"lighthouse_definition_id"`
+	DefinitionName         string                                 `tfschema:"definition_name"`
+	Description            string                                 `tfschema:"description"`
+	ManagingTenantId       string                                 `tfschema:"managing_tenant_id"`
+	ManagingTenantName     string                                 `tfschema:"managing_tenant_name"`
+	ProvisioningState      string                                 `tfschema:"provisioning_state"`
+	Authorizations         []LighthouseAuthorizationModel         `tfschema:"authorization"`
+	EligibleAuthorizations []LighthouseEligibleAuthorizationModel `tfschema:"eligible_authorization"`
+}
+
+type LighthouseAuthorizationModel struct {
+	PrincipalId                string   `tfschema:"principal_id"`
+	PrincipalDisplayName       string   `tfschema:"principal_display_name"`
+	RoleDefinitionId           string   `tfschema:"role_definition_id"`
+	DelegatedRoleDefinitionIds []string `tfschema:"delegated_role_definition_ids"`
+}
+
+type LighthouseEligibleAuthorizationModel struct {
+	PrincipalId            string                                  `tfschema:"principal_id"`
+	PrincipalDisplayName   string                                  `tfschema:"principal_display_name"`
+	RoleDefinitionId       string                                  `tfschema:"role_definition_id"`
+	JustInTimeAccessPolicy []LighthouseJustInTimeAccessPolicyModel `tfschema:"just_in_time_access_policy"`
+}
+
+type LighthouseJustInTimeAccessPolicyModel struct {
+	MultiFactorAuthProvider   string                    `tfschema:"multi_factor_auth_provider"`
+	MaximumActivationDuration string                    `tfschema:"maximum_activation_duration"`
+	ApprovalRequired          bool                      `tfschema:"approval_required"`
+	Approvers                 []LighthouseApproverModel `tfschema:"approver"`
+}
+
+type LighthouseApproverModel struct {
+	PrincipalId          string `tfschema:"principal_id"`
+	PrincipalDisplayName string `tfschema:"principal_display_name"`
+}
+
+func (LighthouseAssignmentsDataSource) ResourceType() string {
+	return "azurerm_lighthouse_assignments"
+}
+
+func (LighthouseAssignmentsDataSource) ModelObject() interface{} {
+	return &LighthouseAssignmentsDataSourceModel{}
+}
+
+func (LighthouseAssignmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.Any(commonids.ValidateSubscriptionID, commonids.ValidateResourceGroupID),
+		},
+	}
+}
+
+func (LighthouseAssignmentsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assignment": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"lighthouse_definition_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"definition_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"managing_tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"managing_tenant_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"provisioning_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"authorization": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"principal_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"principal_display_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"role_definition_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"delegated_role_definition_ids": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+
+					"eligible_authorization": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"principal_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"principal_display_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"role_definition_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"just_in_time_access_policy": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"multi_factor_auth_provider": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"maximum_activation_duration": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"approval_required": {
+												Type:     pluginsdk.TypeBool,
+												Computed: true,
+											},
+
+											"approver": {
+												Type:     pluginsdk.TypeList,
+												Computed: true,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"principal_id": {
+															Type:     pluginsdk.TypeString,
+															Computed: true,
+														},
+
+														"principal_display_name": {
+															Type:     pluginsdk.TypeString,
+															Computed: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (LighthouseAssignmentsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Lighthouse.AssignmentsClient
+
+			var state LighthouseAssignmentsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scopeId := commonids.NewScopeID(state.Scope)
+
+			options := registrationassignments.ListOperationOptions{
+				ExpandRegistrationDefinition: pointer.To(true),
+			}
+			resp, err := client.ListComplete(ctx, scopeId, options)
+			if err != nil {
+				return fmt.Errorf("listing Lighthouse Assignments for %s: %+v", scopeId, err)
+			}
+
+			state.Assignments = flattenLighthouseAssignments(resp.Items)
+
+			metadata.SetID(scopeId)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenLighthouseAssignments(input []registrationassignments.RegistrationAssignment) []LighthouseAssignmentModel {
+	results := make([]LighthouseAssignmentModel, 0)
+	for _, item := range input {
+		assignment := LighthouseAssignmentModel{
+			Id:   pointer.From(item.Id),
+			Name: pointer.From(item.Name),
+		}
+
+		if props := item.Properties; props != nil {
+			assignment.LighthouseDefinitionId = props.RegistrationDefinitionId
+			assignment.ProvisioningState = string(pointer.From(props.ProvisioningState))
+
+			if definition := props.RegistrationDefinition; definition != nil && definition.Properties != nil {
+				definitionProps := definition.Properties
+				assignment.DefinitionName = pointer.From(definitionProps.RegistrationDefinitionName)
+				assignment.Description = pointer.From(definitionProps.Description)
+				assignment.ManagingTenantId = pointer.From(definitionProps.ManagedByTenantId)
+				assignment.ManagingTenantName = pointer.From(definitionProps.ManagedByTenantName)
+				assignment.Authorizations = flattenLighthouseAssignmentAuthorizations(definitionProps.Authorizations)
+				assignment.EligibleAuthorizations = flattenLighthouseAssignmentEligibleAuthorizations(definitionProps.EligibleAuthorizations)
+			}
+		}
+
+		results = append(results, assignment)
+	}
+
+	return results
+}
+
+func flattenLighthouseAssignmentAuthorizations(input *[]registrationassignments.Authorization) []LighthouseAuthorizationModel {
+	results := make([]LighthouseAuthorizationModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, LighthouseAuthorizationModel{
+			PrincipalId:                item.PrincipalId,
+			PrincipalDisplayName:       pointer.From(item.PrincipalIdDisplayName),
+			RoleDefinitionId:           item.RoleDefinitionId,
+			DelegatedRoleDefinitionIds: pointer.From(item.DelegatedRoleDefinitionIds),
+		})
+	}
+
+	return results
+}
+
+func flattenLighthouseAssignmentEligibleAuthorizations(input *[]registrationassignments.EligibleAuthorization) []LighthouseEligibleAuthorizationModel {
+	results := make([]LighthouseEligibleAuthorizationModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		result := LighthouseEligibleAuthorizationModel{
+			PrincipalId:          item.PrincipalId,
+			PrincipalDisplayName: pointer.From(item.PrincipalIdDisplayName),
+			RoleDefinitionId:     item.RoleDefinitionId,
+		}
+
+		if policy := item.JustInTimeAccessPolicy; policy != nil {
+			approvers := make([]LighthouseApproverModel, 0)
+			for _, approver := range pointer.From(policy.ManagedByTenantApprovers) {
+				approvers = append(approvers, LighthouseApproverModel{
+					PrincipalId:          approver.PrincipalId,
+					PrincipalDisplayName: pointer.From(approver.PrincipalIdDisplayName),
+				})
+			}
+
+			result.JustInTimeAccessPolicy = []LighthouseJustInTimeAccessPolicyModel{
+				{
+					MultiFactorAuthProvider:   string(policy.MultiFactorAuthProvider),
+					MaximumActivationDuration: pointer.From(policy.MaximumActivationDuration),
+					// activation requests only need to be approved when the policy defines at least one approver
+					ApprovalRequired: len(approvers) > 0,
+					Approvers:        approvers,
+				},
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
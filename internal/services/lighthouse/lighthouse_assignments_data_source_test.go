@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lighthouse_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LighthouseAssignmentsDataSource struct{}
+
+func TestAccLighthouseAssignmentsDataSource_basic(t *testing.T) {
+	secondTenantID := os.Getenv("ARM_TENANT_ID_ALT")
+	principalID := os.Getenv("ARM_PRINCIPAL_ID_ALT_TENANT")
+	if secondTenantID == "" || principalID == "" {
+		t.Skip("Skipping as ARM_TENANT_ID_ALT and/or ARM_PRINCIPAL_ID_ALT_TENANT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_lighthouse_assignments", "test")
+	r := LighthouseAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(uuid.New().String(), secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("assignment.#").Exists(),
+				check.That(data.ResourceName).Key("assignment.0.managing_tenant_id").Exists(),
+			),
+		},
+	})
+}
+
+func (LighthouseAssignmentsDataSource) basic(id string, secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_role_definition" "contributor" {
+  role_definition_id = "b24988ac-6180-42a0-ab88-20f7382dd24c"
+}
+
+resource "azurerm_lighthouse_definition" "test" {
+  lighthouse_definition_id = "%s"
+  name                     = "acctest-LD-%d"
+  description              = "Acceptance Test Lighthouse Definition"
+  managing_tenant_id       = "%s"
+  scope                    = data.azurerm_subscription.primary.id
+
+  authorization {
+    principal_id           = "%s"
+    role_definition_id     = data.azurerm_role_definition.contributor.role_definition_id
+    principal_display_name = "Tier 1 Support"
+  }
+}
+
+resource "azurerm_lighthouse_assignment" "test" {
+  scope                    = data.azurerm_subscription.primary.id
+  lighthouse_definition_id = azurerm_lighthouse_definition.test.id
+}
+
+data "azurerm_lighthouse_assignments" "test" {
+  scope = data.azurerm_subscription.primary.id
+
+  depends_on = [azurerm_lighthouse_assignment.test]
+}
+`, id, data.RandomInteger, secondTenantID, principalID)
+}
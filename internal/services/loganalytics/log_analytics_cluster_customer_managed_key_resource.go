@@ -154,23 +154,32 @@ func resourceLogAnalyticsClusterCustomerManagedKeyUpdate(d *pluginsdk.ResourceDa
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	model := resp.Model
-	if model == nil {
+	if resp.Model == nil {
 		return fmt.Errorf("retiring `azurerm_log_analytics_cluster` %s: `model` is nil", *id)
 	}
 
-	if props := model.Properties; props == nil {
-		return fmt.Errorf("retiring `azurerm_log_analytics_cluster` %s: `Properties` is nil", *id)
+	// rotating the key only requires the Key Vault properties to be patched, the cluster remains available
+	// whilst the new key is applied so we poll until the cluster has finished updating
+	payload := clusters.ClusterPatch{
+		Properties: &clusters.ClusterPatchProperties{
+			KeyVaultProperties: &clusters.KeyVaultProperties{
+				KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+				KeyName:     utils.String(keyId.Name),
+				KeyVersion:  utils.String(keyId.Version),
+			},
+		},
 	}
 
-	model.Properties.KeyVaultProperties = &clusters.KeyVaultProperties{
-		KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
-		KeyName:     utils.String(keyId.Name),
-		KeyVersion:  utils.String(keyId.Version),
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("rotating Customer Managed Key for %s: %+v", *id, err)
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
-		return fmt.Errorf("updating Customer Managed Key for %s: %+v", *id, err)
+	updateWait, err := logAnalyticsClusterWaitForState(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if _, err := updateWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish rotating Customer Managed Key: %+v", *id, err)
 	}
 
 	return resourceLogAnalyticsClusterCustomerManagedKeyRead(d, meta)
@@ -6,6 +6,7 @@ package loganalytics
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -63,7 +64,7 @@ func (l LogAnalyticsClusterResource) Arguments() map[string]*schema.Schema {
 				}
 				return 500
 			}(),
-			ValidateFunc: validation.IntInSlice([]int{500, 1000, 2000, 5000}),
+			ValidateFunc: validation.IntInSlice(possibleValuesForLogAnalyticsClusterCapacity()),
 		},
 
 		"tags": tags.Schema(),
@@ -230,18 +231,36 @@ func (r LogAnalyticsClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving `azurerm_log_analytics_cluster` %s: `Properties` is nil", *id)
 			}
 
-			if metadata.ResourceData.HasChange("size_gb") && model.Sku != nil && model.Sku.Capacity != nil {
-				model.Sku.Capacity = pointer.To(clusters.Capacity(config.SizeGB))
+			// the capacity reservation level and tags can be changed in-place using a patch, which avoids
+			// re-submitting the full cluster definition (including the Customer Managed Key) on every change
+			payload := clusters.ClusterPatch{}
+
+			if metadata.ResourceData.HasChange("size_gb") {
+				payload.Sku = &clusters.ClusterSku{
+					Capacity: pointer.To(clusters.Capacity(config.SizeGB)),
+					Name:     pointer.To(clusters.ClusterSkuNameEnumCapacityReservation),
+				}
+				if model.Sku != nil && model.Sku.Name != nil {
+					payload.Sku.Name = model.Sku.Name
+				}
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
-				model.Tags = pointer.To(config.Tags)
+				payload.Tags = pointer.To(config.Tags)
 			}
 
-			if err = client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
+			if err = client.UpdateThenPoll(ctx, *id, payload); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
+			updateWait, err := logAnalyticsClusterWaitForState(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			if _, err := updateWait.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to finish updating: %+v", *id, err)
+			}
+
 			return nil
 		},
 	}
@@ -270,3 +289,12 @@ func (r LogAnalyticsClusterResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func possibleValuesForLogAnalyticsClusterCapacity() []int {
+	values := make([]int, 0)
+	for _, v := range clusters.PossibleValuesForCapacity() {
+		values = append(values, int(v))
+	}
+	sort.Ints(values)
+	return values
+}
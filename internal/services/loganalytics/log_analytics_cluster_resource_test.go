@@ -77,12 +77,20 @@ func TestAccLogAnalyticsCluster_resize(t *testing.T) {
 		},
 		data.ImportStep(),
 		{
-			Config: r.resize(data),
+			Config: r.resize(data, 1000),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.resize(data, 2000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("size_gb").HasValue("2000"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -149,7 +157,7 @@ resource "azurerm_log_analytics_cluster" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
-func (r LogAnalyticsClusterResource) resize(data acceptance.TestData) string {
+func (r LogAnalyticsClusterResource) resize(data acceptance.TestData, sizeGB int) string {
 	return fmt.Sprintf(`
 %s
 
@@ -157,13 +165,13 @@ resource "azurerm_log_analytics_cluster" "test" {
   name                = "acctest-LA-%d"
   resource_group_name = azurerm_resource_group.test.name
   location            = azurerm_resource_group.test.location
-  size_gb             = 1000
+  size_gb             = %d
 
   identity {
     type = "SystemAssigned"
   }
 }
-`, r.template(data), data.RandomInteger)
+`, r.template(data), data.RandomInteger, sizeGB)
 }
 
 func (r LogAnalyticsClusterResource) requiresImport(data acceptance.TestData) string {
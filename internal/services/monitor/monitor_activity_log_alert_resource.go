@@ -863,7 +863,10 @@ func flattenMonitorActivityLogAlertCriteria(input activitylogalertsapis.AlertRul
 		if condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
 			values := make([]string, 0)
 			for _, leafCondition := range *condition.AnyOf {
-				if leafCondition.Field != nil && leafCondition.Equals != nil {
+				if leafCondition.Field == nil {
+					continue
+				}
+				if leafCondition.Equals != nil {
 					values = append(values, *leafCondition.Equals)
 				}
 				switch strings.ToLower(*leafCondition.Field) {
@@ -886,11 +889,12 @@ func flattenMonitorActivityLogAlertCriteria(input activitylogalertsapis.AlertRul
 		}
 	}
 
-	if result["category"] == "ResourceHealth" {
+	category, _ := result["category"].(string)
+	if strings.EqualFold(category, "ResourceHealth") {
 		flattenMonitorActivityLogAlertResourceHealth(input, result)
 	}
 
-	if result["category"] == "ServiceHealth" {
+	if strings.EqualFold(category, "ServiceHealth") {
 		flattenMonitorActivityLogAlertServiceHealth(input, result)
 	}
 
@@ -901,21 +905,15 @@ func flattenMonitorActivityLogAlertResourceHealth(input activitylogalertsapis.Al
 	rhResult := make(map[string]interface{})
 
 	for _, condition := range input.AllOf {
-		if condition.Field == nil && condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
-			values := []string{}
-			for _, cond := range *condition.AnyOf {
-				if cond.Field != nil && cond.Equals != nil {
-					values = append(values, *cond.Equals)
-				}
-				switch strings.ToLower(*cond.Field) {
-				case "properties.currenthealthstatus":
-					rhResult["current"] = values
-				case "properties.previoushealthstatus":
-					rhResult["previous"] = values
-				case "properties.cause":
-					rhResult["reason"] = values
-				}
-			}
+		// a single value is returned as a leaf condition rather than being wrapped in an `anyOf` container
+		field, values := flattenMonitorActivityLogAlertConditionValues(condition)
+		switch strings.ToLower(field) {
+		case "properties.currenthealthstatus":
+			rhResult["current"] = values
+		case "properties.previoushealthstatus":
+			rhResult["previous"] = values
+		case "properties.cause":
+			rhResult["reason"] = values
 		}
 	}
 
@@ -924,27 +922,56 @@ func flattenMonitorActivityLogAlertResourceHealth(input activitylogalertsapis.Al
 
 func flattenMonitorActivityLogAlertServiceHealth(input activitylogalertsapis.AlertRuleAllOfCondition, result map[string]interface{}) {
 	shResult := make(map[string]interface{})
+
 	for _, condition := range input.AllOf {
-		if condition.Field != nil && condition.ContainsAny != nil && len(*condition.ContainsAny) > 0 {
-			switch strings.ToLower(*condition.Field) {
-			case "properties.impactedservices[*].impactedregions[*].regionname":
-				shResult["locations"] = *condition.ContainsAny
-			case "properties.impactedservices[*].servicename":
-				shResult["services"] = *condition.ContainsAny
-			}
+		// a single value is returned as a leaf condition rather than being wrapped in an `anyOf` container, and
+		// alerts created outside of Terraform can specify the impacted services and regions using either form
+		field, values := flattenMonitorActivityLogAlertConditionValues(condition)
+		switch strings.ToLower(field) {
+		case "properties.incidenttype":
+			shResult["events"] = values
+		case "properties.impactedservices[*].impactedregions[*].regionname":
+			shResult["locations"] = values
+		case "properties.impactedservices[*].servicename":
+			shResult["services"] = values
 		}
-		if condition.Field == nil && condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
-			events := []string{}
-			for _, evCond := range *condition.AnyOf {
-				if evCond.Field != nil && evCond.Equals != nil {
-					events = append(events, *evCond.Equals)
-				}
+	}
+
+	result["service_health"] = []interface{}{shResult}
+}
+
+// flattenMonitorActivityLogAlertConditionValues returns the field and the values a condition matches on, regardless of
+// whether this is specified as a leaf condition using `equals`/`containsAny` or using an `anyOf` container
+func flattenMonitorActivityLogAlertConditionValues(condition activitylogalertsapis.AlertRuleAnyOfOrLeafCondition) (string, []string) {
+	values := make([]string, 0)
+
+	if condition.Field != nil {
+		if condition.Equals != nil {
+			values = append(values, *condition.Equals)
+		}
+		if condition.ContainsAny != nil {
+			values = append(values, *condition.ContainsAny...)
+		}
+		return *condition.Field, values
+	}
+
+	field := ""
+	if condition.AnyOf != nil {
+		for _, leafCondition := range *condition.AnyOf {
+			if leafCondition.Field == nil {
+				continue
+			}
+			field = *leafCondition.Field
+			if leafCondition.Equals != nil {
+				values = append(values, *leafCondition.Equals)
+			}
+			if leafCondition.ContainsAny != nil {
+				values = append(values, *leafCondition.ContainsAny...)
 			}
-			shResult["events"] = events
 		}
 	}
 
-	result["service_health"] = []interface{}{shResult}
+	return field, values
 }
 
 func flattenMonitorActivityLogAlertAction(input activitylogalertsapis.ActionList) (result []interface{}) {
@@ -315,6 +315,25 @@ func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndDelete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ServiceHealth_singleValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.serviceHealth_singleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.events.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.services.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.locations.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action.0.webhook_properties.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_location(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -952,6 +971,51 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 	`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) serviceHealth_singleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [data.azurerm_subscription.current.id]
+
+  criteria {
+    category = "ServiceHealth"
+    service_health {
+      events    = ["Incident"]
+      services  = ["Activity Logs & Alerts"]
+      locations = ["Global"]
+    }
+  }
+
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+
+    webhook_properties = {
+      from = "terraform test"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (MonitorActivityLogAlertResource) resourceHealth_basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AlertProcessingRuleScheduleOverrideModel struct {
+	AlertProcessingRuleId string            `tfschema:"alert_processing_rule_id"`
+	Enabled               bool              `tfschema:"enabled"`
+	Triggers              map[string]string `tfschema:"triggers"`
+}
+
+type AlertProcessingRuleScheduleOverrideResource struct{}
+
+var _ sdk.ResourceWithUpdate = AlertProcessingRuleScheduleOverrideResource{}
+
+func (r AlertProcessingRuleScheduleOverrideResource) ResourceType() string {
+	return "azurerm_monitor_alert_processing_rule_schedule_override"
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) ModelObject() interface{} {
+	return &AlertProcessingRuleScheduleOverrideModel{}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AlertProcessingRuleScheduleOverrideID
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"alert_processing_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: alertprocessingrules.ValidateActionRuleID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			var model AlertProcessingRuleScheduleOverrideModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ruleId, err := alertprocessingrules.ParseActionRuleID(model.AlertProcessingRuleId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAlertProcessingRuleScheduleOverrideID(ruleId.SubscriptionId, ruleId.ResourceGroupName, ruleId.ActionRuleName, "default")
+
+			locks.ByID(ruleId.ID())
+			defer locks.UnlockByID(ruleId.ID())
+
+			if _, err := client.GetByName(ctx, *ruleId); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *ruleId, err)
+			}
+
+			if err := setAlertProcessingRuleEnabled(ctx, client, *ruleId, model.Enabled); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			id, err := parse.AlertProcessingRuleScheduleOverrideID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			ruleId := alertprocessingrules.NewActionRuleID(id.SubscriptionId, id.ResourceGroup, id.ActionRuleName)
+
+			resp, err := client.GetByName(ctx, ruleId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", ruleId, err)
+			}
+
+			// the triggers only exist within the state, so these are retained from the state
+			var state AlertProcessingRuleScheduleOverrideModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.AlertProcessingRuleId = ruleId.ID()
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.Enabled != nil {
+						state.Enabled = *props.Enabled
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			id, err := parse.AlertProcessingRuleScheduleOverrideID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertProcessingRuleScheduleOverrideModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ruleId := alertprocessingrules.NewActionRuleID(id.SubscriptionId, id.ResourceGroup, id.ActionRuleName)
+
+			locks.ByID(ruleId.ID())
+			defer locks.UnlockByID(ruleId.ID())
+
+			if metadata.ResourceData.HasChange("enabled") {
+				if err := setAlertProcessingRuleEnabled(ctx, client, ruleId, model.Enabled); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleScheduleOverrideResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: removing this resource leaves the Alert Processing Rule in its current enabled/disabled state
+			return nil
+		},
+	}
+}
+
+func setAlertProcessingRuleEnabled(ctx context.Context, client *alertprocessingrules.AlertProcessingRulesClient, id alertprocessingrules.ActionRuleId, enabled bool) error {
+	payload := alertprocessingrules.PatchObject{
+		Properties: &alertprocessingrules.PatchProperties{
+			Enabled: pointer.To(enabled),
+		},
+	}
+
+	if _, err := client.Update(ctx, id, payload); err != nil {
+		if enabled {
+			return fmt.Errorf("enabling %s: %+v", id, err)
+		}
+		return fmt.Errorf("disabling %s: %+v", id, err)
+	}
+
+	return nil
+}
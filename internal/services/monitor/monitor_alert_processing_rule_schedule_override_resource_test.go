@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorAlertProcessingRuleScheduleOverrideResource struct{}
+
+func TestAccMonitorAlertProcessingRuleScheduleOverride_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_schedule_override", "test")
+	r := MonitorAlertProcessingRuleScheduleOverrideResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func TestAccMonitorAlertProcessingRuleScheduleOverride_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_schedule_override", "test")
+	r := MonitorAlertProcessingRuleScheduleOverrideResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r MonitorAlertProcessingRuleScheduleOverrideResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.AlertProcessingRuleScheduleOverrideID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleId := alertprocessingrules.NewActionRuleID(id.SubscriptionId, id.ResourceGroup, id.ActionRuleName)
+	resp, err := client.Monitor.AlertProcessingRulesClient.GetByName(ctx, ruleId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", ruleId, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MonitorAlertProcessingRuleScheduleOverrideResource) basic(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-maprso-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moniter-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  enabled             = false
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
+
+resource "azurerm_monitor_alert_processing_rule_schedule_override" "test" {
+  alert_processing_rule_id = azurerm_monitor_alert_processing_rule_suppression.test.id
+  enabled                  = %[3]t
+
+  triggers = {
+    change_window = "acctest-%[1]d"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
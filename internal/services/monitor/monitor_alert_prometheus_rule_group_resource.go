@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -58,7 +62,10 @@ type PrometheusRuleAlertResolutionModel struct {
 
 type AlertPrometheusRuleGroupResource struct{}
 
-var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+var (
+	_ sdk.ResourceWithUpdate        = AlertPrometheusRuleGroupResource{}
+	_ sdk.ResourceWithCustomizeDiff = AlertPrometheusRuleGroupResource{}
+)
 
 func (r AlertPrometheusRuleGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_prometheus_rule_group"
@@ -76,6 +83,20 @@ func (r AlertPrometheusRuleGroupResource) CustomizeDiff() sdk.ResourceFunc {
 				return fmt.Errorf("DecodeDiff: %+v", err)
 			}
 
+			// when the rule group is scoped to a Managed Kubernetes Cluster the `cluster_name` must refer to that cluster
+			clusterScopes := make([]string, 0)
+			for _, scope := range model.Scopes {
+				if clusterId, err := commonids.ParseKubernetesClusterIDInsensitively(scope); err == nil {
+					clusterScopes = append(clusterScopes, clusterId.ManagedClusterName)
+				}
+			}
+			if len(clusterScopes) > 1 {
+				return fmt.Errorf("at most one Managed Kubernetes Cluster can be specified in `scopes` for %s", model.Name)
+			}
+			if len(clusterScopes) == 1 && model.ClusterName != "" && !strings.EqualFold(clusterScopes[0], model.ClusterName) {
+				return fmt.Errorf("`cluster_name` (%s) must match the name of the Managed Kubernetes Cluster specified in `scopes` (%s) for %s", model.ClusterName, clusterScopes[0], model.Name)
+			}
+
 			for i, r := range model.Rule {
 				if (r.Alert != "" && r.Record != "") || (r.Alert == "" && r.Record == "") {
 					return fmt.Errorf("one and only one of [rule.%d.record, rule.%d.alert] for %s must be set", i, i, model.Name)
@@ -120,6 +141,7 @@ func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Sche
 		"rule": {
 			Type:     pluginsdk.TypeList,
 			Required: true,
+			MaxItems: 20,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"action": {
@@ -173,7 +195,7 @@ func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Sche
 					"for": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ISO8601Duration,
 					},
 
 					"labels": {
@@ -204,7 +226,7 @@ func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Sche
 								"time_to_resolve": {
 									Type:         pluginsdk.TypeString,
 									Optional:     true,
-									ValidateFunc: validation.StringIsNotEmpty,
+									ValidateFunc: validate.ISO8601Duration,
 								},
 							},
 						},
@@ -222,8 +244,11 @@ func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Sche
 		"scopes": {
 			Type:     pluginsdk.TypeList,
 			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
 			Elem: &pluginsdk.Schema{
-				Type: pluginsdk.TypeString,
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
 			},
 		},
 
@@ -242,7 +267,7 @@ func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Sche
 		"interval": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validate.ISO8601DurationBetween("PT1M", "PT15M"),
 		},
 
 		"rule_group_enabled": {
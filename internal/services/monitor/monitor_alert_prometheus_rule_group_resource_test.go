@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -82,6 +83,17 @@ func TestAccAlertsManagementPrometheusRuleGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccAlertsManagementPrometheusRuleGroup_clusterNameMismatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := AlertPrometheusRuleGroupTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.clusterNameMismatch(data),
+			ExpectError: regexp.MustCompile("must match the name of the Managed Kubernetes Cluster specified in `scopes`"),
+		},
+	})
+}
+
 func (r AlertPrometheusRuleGroupTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(state.ID)
 	if err != nil {
@@ -350,3 +362,33 @@ EOF
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r AlertPrometheusRuleGroupTestResource) clusterNameMismatch(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[3]s"
+  cluster_name        = "acctestaks-other"
+  interval            = "PT5M"
+  scopes = [
+    azurerm_monitor_workspace.test.id,
+    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctest-rg-%[2]d/providers/Microsoft.ContainerService/managedClusters/acctestaks%[2]d",
+  ]
+
+  rule {
+    expression = <<EOF
+histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service="billing-processing"}[5m])) by (job_type))
+EOF
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary)
+}
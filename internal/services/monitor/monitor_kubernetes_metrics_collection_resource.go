@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	kubernetesMetricsCollectionDataSourceName  = "PrometheusDataSource"
+	kubernetesMetricsCollectionDestinationName = "MonitoringAccount1"
+)
+
+type KubernetesMetricsCollectionResourceModel struct {
+	Name                            string            `tfschema:"name"`
+	ResourceGroupName               string            `tfschema:"resource_group_name"`
+	Location                        string            `tfschema:"location"`
+	KubernetesClusterId             string            `tfschema:"kubernetes_cluster_id"`
+	MonitorWorkspaceId              string            `tfschema:"monitor_workspace_id"`
+	Tags                            map[string]string `tfschema:"tags"`
+	DataCollectionEndpointId        string            `tfschema:"data_collection_endpoint_id"`
+	DataCollectionRuleId            string            `tfschema:"data_collection_rule_id"`
+	DataCollectionRuleAssociationId string            `tfschema:"data_collection_rule_association_id"`
+}
+
+type KubernetesMetricsCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesMetricsCollectionResource{}
+
+func (r KubernetesMetricsCollectionResource) ResourceType() string {
+	return "azurerm_monitor_kubernetes_metrics_collection"
+}
+
+func (r KubernetesMetricsCollectionResource) ModelObject() interface{} {
+	return &KubernetesMetricsCollectionResourceModel{}
+}
+
+func (r KubernetesMetricsCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
+}
+
+func (r KubernetesMetricsCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 44),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"kubernetes_cluster_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KubernetesClusterId{}),
+
+		"monitor_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azuremonitorworkspaces.ValidateAccountID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesMetricsCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_collection_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_collection_rule_association_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesMetricsCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model KubernetesMetricsCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(clusterId.ID(), model.Name)
+			existing, err := associationsClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			endpointId := datacollectionendpoints.NewDataCollectionEndpointID(subscriptionId, model.ResourceGroupName, model.Name)
+			existingEndpoint, err := endpointsClient.Get(ctx, endpointId)
+			if err != nil && !response.WasNotFound(existingEndpoint.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", endpointId, err)
+			}
+			if !response.WasNotFound(existingEndpoint.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), endpointId.ID())
+			}
+
+			ruleId := datacollectionrules.NewDataCollectionRuleID(subscriptionId, model.ResourceGroupName, model.Name)
+			existingRule, err := rulesClient.Get(ctx, ruleId)
+			if err != nil && !response.WasNotFound(existingRule.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", ruleId, err)
+			}
+			if !response.WasNotFound(existingRule.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), ruleId.ID())
+			}
+
+			endpoint := datacollectionendpoints.DataCollectionEndpointResource{
+				Kind:       pointer.To(datacollectionendpoints.KnownDataCollectionEndpointResourceKindLinux),
+				Location:   location.Normalize(model.Location),
+				Properties: &datacollectionendpoints.DataCollectionEndpoint{},
+				Tags:       pointer.To(model.Tags),
+			}
+			if _, err := endpointsClient.Create(ctx, endpointId, endpoint); err != nil {
+				return fmt.Errorf("creating %s: %+v", endpointId, err)
+			}
+
+			// the Data Collection Endpoint and Rule are only tracked once the association exists, so they're
+			// removed again when a later step fails rather than being left behind outside of the state
+			rule := datacollectionrules.DataCollectionRuleResource{
+				Kind:       pointer.To(datacollectionrules.KnownDataCollectionRuleResourceKindLinux),
+				Location:   location.Normalize(model.Location),
+				Properties: expandKubernetesMetricsCollectionRule(endpointId, model.MonitorWorkspaceId),
+				Tags:       pointer.To(model.Tags),
+			}
+			if _, err := rulesClient.Create(ctx, ruleId, rule); err != nil {
+				if _, deleteErr := endpointsClient.Delete(ctx, endpointId); deleteErr != nil {
+					return fmt.Errorf("creating %s: %+v (additionally, rolling back the creation of %s failed: %+v)", ruleId, err, endpointId, deleteErr)
+				}
+				return fmt.Errorf("creating %s: %+v", ruleId, err)
+			}
+
+			association := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+				Name: pointer.To(model.Name),
+				Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{
+					DataCollectionRuleId: pointer.To(ruleId.ID()),
+					Description:          pointer.To("Association of data collection rule. Deleting this association will break the data collection for this AKS Cluster."),
+				},
+			}
+			if _, err := associationsClient.Create(ctx, id, association); err != nil {
+				if _, deleteErr := rulesClient.Delete(ctx, ruleId); deleteErr != nil {
+					return fmt.Errorf("creating %s: %+v (additionally, rolling back the creation of %s failed: %+v)", id, err, ruleId, deleteErr)
+				}
+				if _, deleteErr := endpointsClient.Delete(ctx, endpointId); deleteErr != nil {
+					return fmt.Errorf("creating %s: %+v (additionally, rolling back the creation of %s failed: %+v)", id, err, endpointId, deleteErr)
+				}
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesMetricsCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterIDInsensitively(id.ResourceUri)
+			if err != nil {
+				return err
+			}
+
+			resp, err := associationsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesMetricsCollectionResourceModel{
+				Name:                            id.DataCollectionRuleAssociationName,
+				KubernetesClusterId:             clusterId.ID(),
+				DataCollectionRuleAssociationId: id.ID(),
+			}
+
+			ruleIdRaw := ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				ruleIdRaw = pointer.From(model.Properties.DataCollectionRuleId)
+			}
+			if ruleIdRaw == "" {
+				return fmt.Errorf("retrieving %s: `dataCollectionRuleId` was nil", *id)
+			}
+
+			ruleId, err := datacollectionrules.ParseDataCollectionRuleIDInsensitively(ruleIdRaw)
+			if err != nil {
+				return err
+			}
+
+			rule, err := rulesClient.Get(ctx, *ruleId)
+			if err != nil {
+				if response.WasNotFound(rule.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *ruleId, err)
+			}
+
+			state.ResourceGroupName = ruleId.ResourceGroupName
+			state.DataCollectionRuleId = ruleId.ID()
+
+			if model := rule.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.DataCollectionEndpointId != nil {
+						endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(*props.DataCollectionEndpointId)
+						if err != nil {
+							return err
+						}
+						state.DataCollectionEndpointId = endpointId.ID()
+					}
+
+					if props.Destinations != nil && props.Destinations.MonitoringAccounts != nil {
+						for _, account := range *props.Destinations.MonitoringAccounts {
+							if pointer.From(account.Name) != kubernetesMetricsCollectionDestinationName || account.AccountResourceId == nil {
+								continue
+							}
+							workspaceId, err := azuremonitorworkspaces.ParseAccountIDInsensitively(*account.AccountResourceId)
+							if err != nil {
+								return err
+							}
+							state.MonitorWorkspaceId = workspaceId.ID()
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesMetricsCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+
+			var model KubernetesMetricsCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ruleId, err := datacollectionrules.ParseDataCollectionRuleID(model.DataCollectionRuleId)
+			if err != nil {
+				return err
+			}
+
+			endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(model.DataCollectionEndpointId)
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				endpoint, err := endpointsClient.Get(ctx, *endpointId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+				}
+				if endpoint.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+				}
+
+				payload := *endpoint.Model
+				payload.Tags = pointer.To(model.Tags)
+				if _, err := endpointsClient.Create(ctx, *endpointId, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *endpointId, err)
+				}
+			}
+
+			rule, err := rulesClient.Get(ctx, *ruleId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *ruleId, err)
+			}
+			if rule.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *ruleId)
+			}
+
+			payload := *rule.Model
+			if metadata.ResourceData.HasChange("monitor_workspace_id") {
+				payload.Properties = expandKubernetesMetricsCollectionRule(*endpointId, model.MonitorWorkspaceId)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if _, err := rulesClient.Create(ctx, *ruleId, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *ruleId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesMetricsCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesMetricsCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the association has to be removed before the rule and endpoint it references can be deleted
+			if resp, err := associationsClient.Delete(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if model.DataCollectionRuleId != "" {
+				ruleId, err := datacollectionrules.ParseDataCollectionRuleID(model.DataCollectionRuleId)
+				if err != nil {
+					return err
+				}
+				if resp, err := rulesClient.Delete(ctx, *ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *ruleId, err)
+				}
+			}
+
+			if model.DataCollectionEndpointId != "" {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(model.DataCollectionEndpointId)
+				if err != nil {
+					return err
+				}
+				if resp, err := endpointsClient.Delete(ctx, *endpointId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *endpointId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesMetricsCollectionRule(endpointId datacollectionendpoints.DataCollectionEndpointId, monitorWorkspaceId string) *datacollectionrules.DataCollectionRule {
+	return &datacollectionrules.DataCollectionRule{
+		DataCollectionEndpointId: pointer.To(endpointId.ID()),
+		DataSources: &datacollectionrules.DataSourcesSpec{
+			PrometheusForwarder: &[]datacollectionrules.PrometheusForwarderDataSource{
+				{
+					Name: pointer.To(kubernetesMetricsCollectionDataSourceName),
+					Streams: &[]datacollectionrules.KnownPrometheusForwarderDataSourceStreams{
+						datacollectionrules.KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics,
+					},
+				},
+			},
+		},
+		Destinations: &datacollectionrules.DestinationsSpec{
+			MonitoringAccounts: &[]datacollectionrules.MonitoringAccountDestination{
+				{
+					AccountResourceId: pointer.To(monitorWorkspaceId),
+					Name:              pointer.To(kubernetesMetricsCollectionDestinationName),
+				},
+			},
+		},
+		DataFlows: &[]datacollectionrules.DataFlow{
+			{
+				Destinations: &[]string{kubernetesMetricsCollectionDestinationName},
+				Streams: &[]datacollectionrules.KnownDataFlowStreams{
+					datacollectionrules.KnownDataFlowStreams(datacollectionrules.KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesMetricsCollectionTestResource struct{}
+
+func TestAccMonitorKubernetesMetricsCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_kubernetes_metrics_collection", "test")
+	r := KubernetesMetricsCollectionTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_collection_endpoint_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("data_collection_rule_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorKubernetesMetricsCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_kubernetes_metrics_collection", "test")
+	r := KubernetesMetricsCollectionTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorKubernetesMetricsCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_kubernetes_metrics_collection", "test")
+	r := KubernetesMetricsCollectionTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesMetricsCollectionTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.DataCollectionRuleAssociationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesMetricsCollectionTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-amw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_workspace" "other" {
+  name                = "acctest-amw2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  monitor_metrics {}
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r KubernetesMetricsCollectionTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_kubernetes_metrics_collection" "test" {
+  name                  = "acctest-msprom-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  monitor_workspace_id  = azurerm_monitor_workspace.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesMetricsCollectionTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_kubernetes_metrics_collection" "import" {
+  name                  = azurerm_monitor_kubernetes_metrics_collection.test.name
+  resource_group_name   = azurerm_monitor_kubernetes_metrics_collection.test.resource_group_name
+  location              = azurerm_monitor_kubernetes_metrics_collection.test.location
+  kubernetes_cluster_id = azurerm_monitor_kubernetes_metrics_collection.test.kubernetes_cluster_id
+  monitor_workspace_id  = azurerm_monitor_kubernetes_metrics_collection.test.monitor_workspace_id
+}
+`, r.basic(data))
+}
+
+func (r KubernetesMetricsCollectionTestResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_kubernetes_metrics_collection" "test" {
+  name                  = "acctest-msprom-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  monitor_workspace_id  = azurerm_monitor_workspace.other.id
+
+  tags = {
+    env = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
@@ -47,6 +49,8 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 			0: migration.MetricAlertUpgradeV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorMetricAlertCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -388,6 +392,81 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 	}
 }
 
+// resourceMonitorMetricAlertCustomizeDiff validates the scopes of a multi-resource Metric Alert at plan time, since
+// otherwise these are only rejected by the API once the Metric Alert is created
+func resourceMonitorMetricAlertCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("scopes") || !d.NewValueKnown("target_resource_type") || !d.NewValueKnown("target_resource_location") {
+		return nil
+	}
+
+	scopes := d.Get("scopes").(*pluginsdk.Set).List()
+
+	multiResource := len(scopes) > 1
+	resourceTypes := make(map[string]string)
+	for _, item := range scopes {
+		scope, ok := item.(string)
+		if !ok || scope == "" {
+			// the scope isn't known yet
+			return nil
+		}
+
+		if _, err := commonids.ParseSubscriptionIDInsensitively(scope); err == nil {
+			multiResource = true
+			continue
+		}
+		if _, err := commonids.ParseResourceGroupIDInsensitively(scope); err == nil {
+			multiResource = true
+			continue
+		}
+
+		if resourceType := monitorMetricAlertScopeResourceType(scope); resourceType != "" {
+			resourceTypes[strings.ToLower(resourceType)] = resourceType
+		}
+	}
+
+	if !multiResource {
+		return nil
+	}
+
+	targetResourceType := d.Get("target_resource_type").(string)
+	if targetResourceType == "" {
+		return fmt.Errorf("`target_resource_type` must be specified when `scopes` contains a Subscription, a Resource Group or multiple Resources")
+	}
+	if d.Get("target_resource_location").(string) == "" {
+		return fmt.Errorf("`target_resource_location` must be specified when `scopes` contains a Subscription, a Resource Group or multiple Resources")
+	}
+
+	// all of the Resources within a multi-resource Metric Alert must be of the `target_resource_type`
+	for key, resourceType := range resourceTypes {
+		if key != strings.ToLower(targetResourceType) {
+			return fmt.Errorf("all of the Resources in `scopes` must be of the `target_resource_type` %q but got a Resource of type %q", targetResourceType, resourceType)
+		}
+	}
+
+	return nil
+}
+
+// monitorMetricAlertScopeResourceType returns the Resource Type (e.g. `Microsoft.Compute/virtualMachines`) of the
+// Resource ID used as a scope, or an empty string when this can't be determined
+func monitorMetricAlertScopeResourceType(scope string) string {
+	index := strings.LastIndex(strings.ToLower(scope), "/providers/")
+	if index == -1 {
+		return ""
+	}
+
+	// the segments following the Resource Provider alternate between the Resource Type and the Resource Name
+	segments := strings.Split(strings.Trim(scope[index+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 == 0 {
+		return ""
+	}
+
+	resourceType := segments[0]
+	for i := 1; i < len(segments); i += 2 {
+		resourceType = fmt.Sprintf("%s/%s", resourceType, segments[i])
+	}
+	return resourceType
+}
+
 func resourceMonitorMetricAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.MetricAlertsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-03-01/metricalerts"
@@ -151,6 +152,18 @@ func TestAccMonitorMetricAlert_multiScope(t *testing.T) {
 	})
 }
 
+func TestAccMonitorMetricAlert_subscriptionScopeWithoutTargetResourceType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.subscriptionScopeWithoutTargetResourceType(data),
+			ExpectError: regexp.MustCompile("`target_resource_type` must be specified"),
+		},
+	})
+}
+
 func TestAccMonitorMetricAlert_applicationInsightsWebTest(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
 	r := MonitorMetricAlertResource{}
@@ -224,6 +237,35 @@ resource "azurerm_monitor_metric_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
 
+func (MonitorMetricAlertResource) subscriptionScopeWithoutTargetResourceType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [data.azurerm_subscription.current.id]
+
+  criteria {
+    metric_namespace = "Microsoft.Compute/virtualMachines"
+    metric_name      = "Percentage CPU"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 90
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r MonitorMetricAlertResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AlertProcessingRuleScheduleOverrideId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	ActionRuleName       string
+	ScheduleOverrideName string
+}
+
+func NewAlertProcessingRuleScheduleOverrideID(subscriptionId, resourceGroup, actionRuleName, scheduleOverrideName string) AlertProcessingRuleScheduleOverrideId {
+	return AlertProcessingRuleScheduleOverrideId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		ActionRuleName:       actionRuleName,
+		ScheduleOverrideName: scheduleOverrideName,
+	}
+}
+
+func (id AlertProcessingRuleScheduleOverrideId) String() string {
+	segments := []string{
+		fmt.Sprintf("Schedule Override Name %q", id.ScheduleOverrideName),
+		fmt.Sprintf("Action Rule Name %q", id.ActionRuleName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Alert Processing Rule Schedule Override", segmentsStr)
+}
+
+func (id AlertProcessingRuleScheduleOverrideId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AlertsManagement/actionRules/%s/scheduleOverrides/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ActionRuleName, id.ScheduleOverrideName)
+}
+
+// AlertProcessingRuleScheduleOverrideID parses a AlertProcessingRuleScheduleOverride ID into an AlertProcessingRuleScheduleOverrideId struct
+func AlertProcessingRuleScheduleOverrideID(input string) (*AlertProcessingRuleScheduleOverrideId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an AlertProcessingRuleScheduleOverride ID: %+v", input, err)
+	}
+
+	resourceId := AlertProcessingRuleScheduleOverrideId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ActionRuleName, err = id.PopSegment("actionRules"); err != nil {
+		return nil, err
+	}
+	if resourceId.ScheduleOverrideName, err = id.PopSegment("scheduleOverrides"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = AlertProcessingRuleScheduleOverrideId{}
+
+func TestAlertProcessingRuleScheduleOverrideIDFormatter(t *testing.T) {
+	actual := NewAlertProcessingRuleScheduleOverrideID("12345678-1234-9876-4563-123456789012", "group1", "actionRule1", "default").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1/scheduleOverrides/default"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAlertProcessingRuleScheduleOverrideID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *AlertProcessingRuleScheduleOverrideId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing ActionRuleName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/",
+			Error: true,
+		},
+
+		{
+			// missing value for ActionRuleName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/",
+			Error: true,
+		},
+
+		{
+			// missing ScheduleOverrideName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1/",
+			Error: true,
+		},
+
+		{
+			// missing value for ScheduleOverrideName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1/scheduleOverrides/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1/scheduleOverrides/default",
+			Expected: &AlertProcessingRuleScheduleOverrideId{
+				SubscriptionId:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "group1",
+				ActionRuleName:       "actionRule1",
+				ScheduleOverrideName: "default",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES/ACTIONRULE1/SCHEDULEOVERRIDES/DEFAULT",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := AlertProcessingRuleScheduleOverrideID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ActionRuleName != v.Expected.ActionRuleName {
+			t.Fatalf("Expected %q but got %q for ActionRuleName", v.Expected.ActionRuleName, actual.ActionRuleName)
+		}
+		if actual.ScheduleOverrideName != v.Expected.ScheduleOverrideName {
+			t.Fatalf("Expected %q but got %q for ScheduleOverrideName", v.Expected.ScheduleOverrideName, actual.ScheduleOverrideName)
+		}
+	}
+}
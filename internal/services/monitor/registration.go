@@ -32,11 +32,13 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AlertProcessingRuleActionGroupResource{},
 		AlertProcessingRuleSuppressionResource{},
+		AlertProcessingRuleScheduleOverrideResource{},
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 		AlertPrometheusRuleGroupResource{},
+		KubernetesMetricsCollectionResource{},
 		WorkspaceResource{},
 	}
 }
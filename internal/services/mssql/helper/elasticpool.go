@@ -24,6 +24,10 @@ type sku struct {
 	Capacity                                          int
 	MaxAllowedGB, MaxSizeGb, MinCapacity, MaxCapacity float64
 	SkuType                                           skuType
+
+	// PerDatabaseSettingsSpecified is false when `per_database_settings` is omitted, in which case the service
+	// defaults are used and so there's nothing to validate against the SKU
+	PerDatabaseSettingsSpecified bool
 }
 
 // getDTUMaxGB: this map holds all of the DTU to 'max_size_gb' mappings based on a DTU lookup
@@ -214,6 +218,23 @@ var getvCoreMaxGB = map[string]map[string]map[int]float64{
 			40: 4096,
 			80: 4096,
 		},
+		"prms": {
+			4:   1024,
+			6:   1536,
+			8:   2048,
+			10:  2048,
+			12:  3072,
+			14:  3072,
+			16:  3072,
+			18:  3072,
+			20:  3072,
+			24:  4096,
+			32:  4096,
+			40:  4096,
+			64:  4096,
+			80:  4096,
+			128: 4096,
+		},
 	},
 }
 
@@ -234,7 +255,16 @@ var getTierFromName = map[string]string{
 	"bc_gen4":      "BusinessCritical",
 	"bc_gen5":      "BusinessCritical",
 	"bc_dc":        "BusinessCritical",
-	"hs_gen5":      "HyperScale",
+	"hs_gen5":      "Hyperscale",
+	"hs_prms":      "Hyperscale",
+}
+
+// getDTUMaxPerDatabaseCapacity: this map holds the maximum eDTUs which can be assigned to a single
+//                               database within a pool of the DTU based service tier
+var getDTUMaxPerDatabaseCapacity = map[string]float64{
+	"basic":    5,
+	"standard": 3000,
+	"premium":  4000,
 }
 
 func MSSQLElasticPoolValidateSKU(diff *pluginsdk.ResourceDiff) error {
@@ -248,6 +278,13 @@ func MSSQLElasticPoolValidateSKU(diff *pluginsdk.ResourceDiff) error {
 	maxCapacity := diff.Get("per_database_settings.0.max_capacity")
 	enclaveType := diff.Get("enclave_type")
 
+	perDatabaseSettingsSpecified := false
+	if raw := diff.GetRawConfig(); !raw.IsNull() {
+		if v := raw.AsValueMap()["per_database_settings"]; !v.IsNull() && v.IsKnown() && v.LengthInt() > 0 {
+			perDatabaseSettingsSpecified = true
+		}
+	}
+
 	s := sku{
 		Name:        name.(string),
 		Tier:        tier.(string),
@@ -257,6 +294,8 @@ func MSSQLElasticPoolValidateSKU(diff *pluginsdk.ResourceDiff) error {
 		MinCapacity: minCapacity.(float64),
 		MaxCapacity: maxCapacity.(float64),
 		SkuType:     DTU,
+
+		PerDatabaseSettingsSpecified: perDatabaseSettingsSpecified,
 	}
 
 	// Convert Bytes to Gigabytes only if
@@ -310,7 +349,8 @@ func nameTierIsValid(s sku) bool {
 		strings.EqualFold(s.Name, "StandardPool") && !strings.EqualFold(s.Tier, "Standard") ||
 		strings.EqualFold(s.Name, "PremiumPool") && !strings.EqualFold(s.Tier, "Premium") ||
 		strings.HasPrefix(strings.ToLower(s.Name), "gp_") && !strings.EqualFold(s.Tier, "GeneralPurpose") ||
-		strings.HasPrefix(strings.ToLower(s.Name), "bc_") && !strings.EqualFold(s.Tier, "BusinessCritical") {
+		strings.HasPrefix(strings.ToLower(s.Name), "bc_") && !strings.EqualFold(s.Tier, "BusinessCritical") ||
+		strings.HasPrefix(strings.ToLower(s.Name), "hs_") && !strings.EqualFold(s.Tier, "Hyperscale") {
 		return false
 	}
 
@@ -318,7 +358,7 @@ func nameTierIsValid(s sku) bool {
 }
 
 func getFamilyFromName(s sku) string {
-	if !strings.HasPrefix(strings.ToLower(s.Name), "gp_") && !strings.HasPrefix(strings.ToLower(s.Name), "bc_") {
+	if !strings.HasPrefix(strings.ToLower(s.Name), "gp_") && !strings.HasPrefix(strings.ToLower(s.Name), "bc_") && !strings.HasPrefix(strings.ToLower(s.Name), "hs_") {
 		return ""
 	}
 
@@ -337,6 +377,10 @@ func getFamilyFromName(s sku) string {
 		retFamily = "DC"
 	}
 
+	if strings.EqualFold(nameFamily, "PRMS") {
+		retFamily = "PRMS"
+	}
+
 	return retFamily
 }
 
@@ -411,6 +455,10 @@ func doDTUSKUValidation(s sku) error {
 		}
 	}
 
+	if !s.PerDatabaseSettingsSpecified {
+		return nil
+	}
+
 	// All Other DTU based SKU Checks
 	if s.MinCapacity != math.Trunc(s.MinCapacity) {
 		return fmt.Errorf("service tier '%s' must have whole numbers as their 'minCapacity'", s.Tier)
@@ -420,6 +468,18 @@ func doDTUSKUValidation(s sku) error {
 		return fmt.Errorf("service tier '%s' must have whole numbers as their 'maxCapacity'", s.Tier)
 	}
 
+	if maxPerDatabase := getDTUMaxPerDatabaseCapacity[strings.ToLower(s.Tier)]; s.MaxCapacity > maxPerDatabase {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'maxCapacity'(%d) must not be higher than %d eDTUs", s.Tier, int(s.MaxCapacity), int(maxPerDatabase))
+	}
+
+	if s.MaxCapacity > float64(s.Capacity) {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'maxCapacity'(%d) must not be higher than the SKUs 'capacity'(%d) value", s.Tier, int(s.MaxCapacity), s.Capacity)
+	}
+
+	if s.MinCapacity > s.MaxCapacity {
+		return fmt.Errorf("perDatabaseSettings 'maxCapacity'(%d) must be greater than or equal to the perDatabaseSettings 'minCapacity'(%d) value", int(s.MaxCapacity), int(s.MinCapacity))
+	}
+
 	return nil
 }
 
@@ -440,6 +500,19 @@ func doVCoreSKUValidation(s sku) error {
 		return fmt.Errorf("'max_size_gb' must be a whole number, got %f GB", s.MaxSizeGb)
 	}
 
+	if !s.PerDatabaseSettingsSpecified {
+		return nil
+	}
+
+	// vCore based per database settings can be a fraction of a vCore (0.25 or 0.5) or a whole number of vCores
+	if !isValidVCorePerDatabaseCapacity(s.MinCapacity) {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'minCapacity'(%g) must be 0, 0.25, 0.5 or a whole number of vCores", s.Tier, s.MinCapacity)
+	}
+
+	if s.MaxCapacity == 0 || !isValidVCorePerDatabaseCapacity(s.MaxCapacity) {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'maxCapacity'(%g) must be 0.25, 0.5 or a whole number of vCores", s.Tier, s.MaxCapacity)
+	}
+
 	if s.MaxCapacity > float64(s.Capacity) {
 		return fmt.Errorf("service tier '%s' perDatabaseSettings 'maxCapacity'(%d) must not be higher than the SKUs 'capacity'(%d) value", s.Tier, int(s.MaxCapacity), s.Capacity)
 	}
@@ -450,3 +523,7 @@ func doVCoreSKUValidation(s sku) error {
 
 	return nil
 }
+
+func isValidVCorePerDatabaseCapacity(input float64) bool {
+	return input == 0.25 || input == 0.5 || input == math.Trunc(input)
+}
@@ -220,3 +220,60 @@ func TestValidateMsSqlElasticPoolName(t *testing.T) {
 		}
 	}
 }
+
+func TestElasticPoolPerDatabaseSettingsValidation(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Sku    sku
+		Errors bool
+	}{
+		{
+			Name:   "DTU max capacity exceeds the SKU capacity",
+			Sku:    sku{Name: "StandardPool", Tier: "Standard", Capacity: 50, MaxAllowedGB: 500, MaxSizeGb: 50, MinCapacity: 0, MaxCapacity: 100, SkuType: DTU, PerDatabaseSettingsSpecified: true},
+			Errors: true,
+		},
+		{
+			Name:   "DTU min capacity exceeds the max capacity",
+			Sku:    sku{Name: "StandardPool", Tier: "Standard", Capacity: 50, MaxAllowedGB: 500, MaxSizeGb: 50, MinCapacity: 20, MaxCapacity: 10, SkuType: DTU, PerDatabaseSettingsSpecified: true},
+			Errors: true,
+		},
+		{
+			Name:   "DTU valid",
+			Sku:    sku{Name: "StandardPool", Tier: "Standard", Capacity: 50, MaxAllowedGB: 500, MaxSizeGb: 50, MinCapacity: 10, MaxCapacity: 50, SkuType: DTU, PerDatabaseSettingsSpecified: true},
+			Errors: false,
+		},
+		{
+			Name:   "DTU per database settings omitted",
+			Sku:    sku{Name: "StandardPool", Tier: "Standard", Capacity: 50, MaxAllowedGB: 500, MaxSizeGb: 50, MinCapacity: 0, MaxCapacity: 100, SkuType: DTU},
+			Errors: false,
+		},
+		{
+			Name:   "vCore fractional max capacity",
+			Sku:    sku{Name: "HS_Gen5", Tier: "Hyperscale", Family: "Gen5", Capacity: 4, MaxAllowedGB: 1024, MinCapacity: 0, MaxCapacity: 1.5, SkuType: VCore, PerDatabaseSettingsSpecified: true},
+			Errors: true,
+		},
+		{
+			Name:   "vCore zero max capacity",
+			Sku:    sku{Name: "HS_Gen5", Tier: "Hyperscale", Family: "Gen5", Capacity: 4, MaxAllowedGB: 1024, MinCapacity: 0, MaxCapacity: 0, SkuType: VCore, PerDatabaseSettingsSpecified: true},
+			Errors: true,
+		},
+		{
+			Name:   "vCore valid",
+			Sku:    sku{Name: "HS_Gen5", Tier: "Hyperscale", Family: "Gen5", Capacity: 4, MaxAllowedGB: 1024, MinCapacity: 0.25, MaxCapacity: 4, SkuType: VCore, PerDatabaseSettingsSpecified: true},
+			Errors: false,
+		},
+	}
+
+	for _, tc := range cases {
+		var err error
+		if tc.Sku.SkuType == DTU {
+			err = doDTUSKUValidation(tc.Sku)
+		} else {
+			err = doVCoreSKUValidation(tc.Sku)
+		}
+
+		if (err != nil) != tc.Errors {
+			t.Fatalf("expected %q to error: %t, got: %+v", tc.Name, tc.Errors, err)
+		}
+	}
+}
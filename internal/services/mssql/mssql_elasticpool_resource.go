@@ -121,6 +121,7 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 								"Gen5",
 								"Fsv2",
 								"DC",
+								"PRMS",
 							}, false),
 						},
 					},
@@ -136,7 +137,8 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 
 			"per_database_settings": {
 				Type:     pluginsdk.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -176,6 +178,13 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"high_availability_replica_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
 			// NOTE: The implementation of 'enclave_type' in the API differs slightly between database
 			// and elasticpools. Database does not allow the 'Default' value to be passed for DW or
 			// DC skus, where elasticpools allows 'Default' but will error if you try to set the
@@ -209,6 +218,25 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 					return err
 				}
 
+				tier := diff.Get("sku.0.tier").(string)
+				if v, ok := diff.GetOk("high_availability_replica_count"); ok && v.(int) > 0 && !strings.EqualFold(tier, "Hyperscale") {
+					return fmt.Errorf("`high_availability_replica_count` can only be specified when the `sku` `tier` is `Hyperscale`, got %q", tier)
+				}
+
+				if diff.Get("zone_redundant").(bool) && (strings.EqualFold(tier, "Basic") || strings.EqualFold(tier, "Standard")) {
+					return fmt.Errorf("`zone_redundant` is not supported for the %q service tier", tier)
+				}
+
+				return nil
+			},
+
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// the zone redundancy of a Hyperscale Elastic Pool can only be configured when it's created, for
+				// all other service tiers it can be toggled in-place
+				if diff.Id() != "" && diff.HasChange("zone_redundant") && strings.EqualFold(diff.Get("sku.0.tier").(string), "Hyperscale") {
+					return diff.ForceNew("zone_redundant")
+				}
+
 				return nil
 			},
 
@@ -268,6 +296,11 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		},
 	}
 
+	// NOTE: `0` is a valid value for `high_availability_replica_count`, so the raw config is checked rather than using `GetOk`
+	if !d.GetRawConfig().AsValueMap()["high_availability_replica_count"].IsNull() {
+		elasticPool.Properties.HighAvailabilityReplicaCount = pointer.To(int64(d.Get("high_availability_replica_count").(int)))
+	}
+
 	// NOTE: The service default is actually nil/empty which indicates enclave is disabled. the value `Default` is NOT the default.
 	if v, ok := d.GetOk("enclave_type"); ok && v.(string) != "" {
 		elasticPool.Properties.PreferredEnclaveType = pointer.To(elasticpools.AlwaysEncryptedEnclaveType(v.(string)))
@@ -338,6 +371,7 @@ func resourceMsSqlElasticPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 
 			d.Set("zone_redundant", pointer.From(props.ZoneRedundant))
+			d.Set("high_availability_replica_count", int(pointer.From(props.HighAvailabilityReplicaCount)))
 
 			licenseType := string(elasticpools.ElasticPoolLicenseTypeLicenseIncluded)
 			if props.LicenseType != nil {
@@ -383,7 +417,17 @@ func resourceMsSqlElasticPoolDelete(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func expandMsSqlElasticPoolPerDatabaseSettings(d *pluginsdk.ResourceData) *elasticpools.ElasticPoolPerDatabaseSettings {
+	// when `per_database_settings` is omitted the service defaults for the SKU are used
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if v := raw.AsValueMap()["per_database_settings"]; v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+			return nil
+		}
+	}
+
 	perDatabaseSettings := d.Get("per_database_settings").([]interface{})
+	if len(perDatabaseSettings) == 0 || perDatabaseSettings[0] == nil {
+		return nil
+	}
 	perDatabaseSetting := perDatabaseSettings[0].(map[string]interface{})
 
 	minCapacity := perDatabaseSetting["min_capacity"].(float64)
@@ -328,6 +328,94 @@ func TestAccMsSqlElasticPool_hyperScale(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_hyperScaleHighAvailabilityReplicaCount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hyperScaleHighAvailabilityReplicaCount(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("high_availability_replica_count").HasValue("1"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+		{
+			Config: r.hyperScaleHighAvailabilityReplicaCount(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("high_availability_replica_count").HasValue("2"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
+func TestAccMsSqlElasticPool_highAvailabilityReplicaCountError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.templateVCore(data, "GP_Gen5", "GeneralPurpose", 4, "Gen5", 0.25, 4, "high_availability_replica_count = 1"),
+			ExpectError: regexp.MustCompile("`high_availability_replica_count` can only be specified when the `sku` `tier` is `Hyperscale`"),
+		},
+	})
+}
+
+func TestAccMsSqlElasticPool_zoneRedundantUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateVCore(data, "BC_Gen5", "BusinessCritical", 4, "Gen5", 0.25, 4, "zone_redundant = false"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("false"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+		{
+			Config: r.templateVCore(data, "BC_Gen5", "BusinessCritical", 4, "Gen5", 0.25, 4, "zone_redundant = true"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("true"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
+func TestAccMsSqlElasticPool_defaultPerDatabaseSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultPerDatabaseSettings(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("per_database_settings.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
+func TestAccMsSqlElasticPool_perDatabaseSettingsExceedsCapacityError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.templateDTU(data, "StandardPool", "Standard", 50, 50, 0, 100, false, ""),
+			ExpectError: regexp.MustCompile(`perDatabaseSettings 'maxCapacity'\(100\) must not be higher than the SKUs 'capacity'\(50\) value`),
+		},
+	})
+}
+
 func TestAccMsSqlElasticPool_vCoreToStandardDTU(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
 	r := MsSqlElasticPoolResource{}
@@ -750,6 +838,47 @@ resource "azurerm_mssql_elasticpool" "test" {
 `, data.RandomInteger, data.Locations.Primary, skuName, skuTier, skuCapacity, skuFamily, databaseSettingsMin, databaseSettingsMax, enclaveType)
 }
 
+func (r MsSqlElasticPoolResource) hyperScaleHighAvailabilityReplicaCount(data acceptance.TestData, replicaCount int) string {
+	return r.templateHyperScale(data, "HS_Gen5", "Hyperscale", 4, "Gen5", 0.25, 4, fmt.Sprintf("high_availability_replica_count = %d", replicaCount))
+}
+
+func (MsSqlElasticPoolResource) defaultPerDatabaseSettings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-vcore-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_mssql_server.test.name
+  max_size_gb         = 5
+
+  sku {
+    name     = "GP_Gen5"
+    tier     = "GeneralPurpose"
+    capacity = 4
+    family   = "Gen5"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (MsSqlElasticPoolResource) noLicenseType(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlFailoverGroupDataSourceModel struct {
+	Name                                 string                                `tfschema:"name"`
+	ServerId                             string                                `tfschema:"server_id"`
+	Databases                            []string                              `tfschema:"databases"`
+	PartnerServers                       []PartnerServerModel                  `tfschema:"partner_server"`
+	ReadonlyEndpointFailurePolicyEnabled bool                                  `tfschema:"readonly_endpoint_failover_policy_enabled"`
+	ReadWriteEndpointFailurePolicy       []ReadWriteEndpointFailurePolicyModel `tfschema:"read_write_endpoint_failover_policy"`
+	ReplicationRole                      string                                `tfschema:"replication_role"`
+	Tags                                 map[string]string                     `tfschema:"tags"`
+}
+
+var _ sdk.DataSource = MsSqlFailoverGroupDataSource{}
+
+type MsSqlFailoverGroupDataSource struct{}
+
+func (d MsSqlFailoverGroupDataSource) ResourceType() string {
+	return "azurerm_mssql_failover_group"
+}
+
+func (d MsSqlFailoverGroupDataSource) ModelObject() interface{} {
+	return &MsSqlFailoverGroupDataSourceModel{}
+}
+
+func (d MsSqlFailoverGroupDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+		},
+
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ServerID,
+		},
+	}
+}
+
+func (d MsSqlFailoverGroupDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"databases": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"partner_server": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"location": commonschema.LocationComputed(),
+
+					"role": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"readonly_endpoint_failover_policy_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"read_write_endpoint_failover_policy": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"grace_minutes": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"replication_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": commonschema.TagsDataSource(),
+	}
+}
+
+func (d MsSqlFailoverGroupDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state MsSqlFailoverGroupDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := parse.ServerID(state.ServerId)
+			if err != nil {
+				return err
+			}
+
+			// the server can be within a different subscription, e.g. when looking up the Failover Group from the partner server
+			client := *metadata.Client.MSSQL.FailoverGroupsClient
+			client.SubscriptionID = serverId.SubscriptionId
+
+			id := parse.NewFailoverGroupID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, state.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.ServerId = serverId.ID()
+			state.Tags = tags.ToTypedObject(existing.Tags)
+
+			if props := existing.FailoverGroupProperties; props != nil {
+				state.Databases = pointer.From(props.Databases)
+				state.PartnerServers = MsSqlFailoverGroupResource{}.flattenPartnerServers(props.PartnerServers)
+				state.ReadonlyEndpointFailurePolicyEnabled = props.ReadOnlyEndpoint != nil && props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+				state.ReplicationRole = string(props.ReplicationRole)
+
+				if endpoint := props.ReadWriteEndpoint; endpoint != nil {
+					state.ReadWriteEndpointFailurePolicy = []ReadWriteEndpointFailurePolicyModel{{
+						Mode:         string(endpoint.FailoverPolicy),
+						GraceMinutes: int64(pointer.From(endpoint.FailoverWithDataLossGracePeriodMinutes)),
+					}}
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
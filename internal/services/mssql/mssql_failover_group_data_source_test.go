@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlFailoverGroupDataSource struct{}
+
+func TestAccDataSourceMsSqlFailoverGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_failover_group", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlFailoverGroupDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("partner_server.#").HasValue("1"),
+				check.That(data.ResourceName).Key("partner_server.0.role").HasValue("Secondary"),
+				check.That(data.ResourceName).Key("read_write_endpoint_failover_policy.0.mode").HasValue("Automatic"),
+				check.That(data.ResourceName).Key("read_write_endpoint_failover_policy.0.grace_minutes").HasValue("60"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMsSqlFailoverGroup_partnerServer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_failover_group", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlFailoverGroupDataSource{}.partnerServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("replication_role").HasValue("Secondary"),
+				check.That(data.ResourceName).Key("partner_server.0.role").HasValue("Primary"),
+			),
+		},
+	})
+}
+
+func (MsSqlFailoverGroupDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_failover_group" "test" {
+  name      = azurerm_mssql_failover_group.test.name
+  server_id = azurerm_mssql_failover_group.test.server_id
+}
+`, MsSqlFailoverGroupResource{}.automaticFailover(data))
+}
+
+func (MsSqlFailoverGroupDataSource) partnerServer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_failover_group" "test" {
+  name      = azurerm_mssql_failover_group.test.name
+  server_id = azurerm_mssql_failover_group.test.partner_server.0.id
+}
+`, MsSqlFailoverGroupResource{}.automaticFailover(data))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlFailoverGroupFailoverModel struct {
+	FailoverGroupId      string            `tfschema:"failover_group_id"`
+	AllowDataLossEnabled bool              `tfschema:"allow_data_loss_enabled"`
+	Triggers             map[string]string `tfschema:"triggers"`
+	ReplicationRole      string            `tfschema:"replication_role"`
+}
+
+var _ sdk.Resource = MsSqlFailoverGroupFailoverResource{}
+
+type MsSqlFailoverGroupFailoverResource struct{}
+
+func (r MsSqlFailoverGroupFailoverResource) ResourceType() string {
+	return "azurerm_mssql_failover_group_failover"
+}
+
+func (r MsSqlFailoverGroupFailoverResource) ModelObject() interface{} {
+	return &MsSqlFailoverGroupFailoverModel{}
+}
+
+func (r MsSqlFailoverGroupFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FailoverGroupFailoverID
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"failover_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FailoverGroupID,
+		},
+
+		"allow_data_loss_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"replication_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlFailoverGroupFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			failoverGroupId, err := parse.FailoverGroupID(model.FailoverGroupId)
+			if err != nil {
+				return err
+			}
+
+			// the Failover Group on the secondary server can be within a different subscription to the primary server
+			client := *metadata.Client.MSSQL.FailoverGroupsClient
+			client.SubscriptionID = failoverGroupId.SubscriptionId
+
+			id := parse.NewFailoverGroupFailoverID(failoverGroupId.SubscriptionId, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name, "default")
+
+			locks.ByID(failoverGroupId.ID())
+			defer locks.UnlockByID(failoverGroupId.ID())
+
+			existing, err := client.Get(ctx, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", failoverGroupId, err)
+			}
+			if existing.FailoverGroupProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", failoverGroupId)
+			}
+
+			// a failover is initiated on the secondary server, which then becomes the primary server
+			if existing.FailoverGroupProperties.ReplicationRole == sql.FailoverGroupReplicationRolePrimary {
+				return fmt.Errorf("a failover can only be initiated from the secondary server, but %s belongs to the primary server", failoverGroupId)
+			}
+
+			if model.AllowDataLossEnabled {
+				metadata.Logger.Infof("initiating forced failover (allowing data loss) for %s", failoverGroupId)
+				future, err := client.ForceFailoverAllowDataLoss(ctx, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name)
+				if err != nil {
+					return fmt.Errorf("initiating forced failover for %s: %+v", failoverGroupId, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for the forced failover of %s: %+v", failoverGroupId, err)
+				}
+			} else {
+				metadata.Logger.Infof("initiating planned failover for %s", failoverGroupId)
+				future, err := client.Failover(ctx, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name)
+				if err != nil {
+					return fmt.Errorf("initiating planned failover for %s: %+v", failoverGroupId, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for the planned failover of %s: %+v", failoverGroupId, err)
+				}
+			}
+
+			resp, err := client.Get(ctx, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", failoverGroupId, err)
+			}
+			if resp.FailoverGroupProperties != nil {
+				model.ReplicationRole = string(resp.FailoverGroupProperties.ReplicationRole)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.FailoverGroupFailoverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := *metadata.Client.MSSQL.FailoverGroupsClient
+			client.SubscriptionID = id.SubscriptionId
+
+			failoverGroupId := parse.NewFailoverGroupID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.FailoverGroupName)
+			existing, err := client.Get(ctx, failoverGroupId.ResourceGroup, failoverGroupId.ServerName, failoverGroupId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", failoverGroupId, err)
+			}
+
+			// the replication role is retained from when the failover was initiated, since a later failover
+			// (e.g. an automatic failover) would otherwise be reported as a change to this resource
+			var state MsSqlFailoverGroupFailoverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.FailoverGroupId = failoverGroupId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: removing this resource doesn't fail the Failover Group back to the previous primary server
+			return nil
+		},
+	}
+}
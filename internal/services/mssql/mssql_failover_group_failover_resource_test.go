@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlFailoverGroupFailoverResource struct{}
+
+func TestAccMsSqlFailoverGroupFailover_planned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group_failover", "test")
+	r := MsSqlFailoverGroupFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.planned(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep("allow_data_loss_enabled", "triggers", "replication_role"),
+	})
+}
+
+func TestAccMsSqlFailoverGroupFailover_primaryServer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group_failover", "test")
+	r := MsSqlFailoverGroupFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.primaryServer(data),
+			ExpectError: regexp.MustCompile("a failover can only be initiated from the secondary server"),
+		},
+	})
+}
+
+func (r MsSqlFailoverGroupFailoverResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FailoverGroupFailoverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.FailoverGroupsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.FailoverGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MsSqlFailoverGroupFailoverResource) planned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group_failover" "test" {
+  failover_group_id = "${azurerm_mssql_server.test_secondary.id}/failoverGroups/${azurerm_mssql_failover_group.test.name}"
+}
+`, MsSqlFailoverGroupResource{}.manualFailover(data))
+}
+
+func (r MsSqlFailoverGroupFailoverResource) primaryServer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group_failover" "test" {
+  failover_group_id = azurerm_mssql_failover_group.test.id
+}
+`, MsSqlFailoverGroupResource{}.manualFailover(data))
+}
@@ -6,6 +6,7 @@ package mssql
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
@@ -13,7 +14,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -89,7 +89,7 @@ func (r MsSqlFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 					"id": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validate.ServerID,
 					},
 
 					"location": commonschema.LocationComputed(),
@@ -156,6 +156,13 @@ func (r MsSqlFailoverGroupResource) CustomizeDiff() sdk.ResourceFunc {
 				return fmt.Errorf("DecodeDiff: %+v", err)
 			}
 
+			// the partner servers can be within a different subscription to the primary server, but can't be the primary server itself
+			for i, partner := range model.PartnerServers {
+				if partner.ID != "" && strings.EqualFold(partner.ID, model.ServerId) {
+					return fmt.Errorf("`partner_server.%d.id` cannot be the same as `server_id`", i)
+				}
+			}
+
 			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
 				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) && rwPolicy[0].GraceMinutes < 60 {
 					return fmt.Errorf("`grace_minutes` should be %d or greater when `mode` is %q", 60, sql.ReadWriteEndpointFailoverPolicyAutomatic)
@@ -192,6 +199,17 @@ func (r MsSqlFailoverGroupResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", serverId, err)
 			}
 
+			// the partner servers may be within another subscription, so check they exist before creating the failover group
+			for _, partner := range model.PartnerServers {
+				partnerId, err := commonids.ParseSqlServerID(partner.ID)
+				if err != nil {
+					return err
+				}
+				if _, err = serversClient.Get(ctx, *partnerId, servers.DefaultGetOperationOptions()); err != nil {
+					return fmt.Errorf("retrieving partner %s: %+v", partnerId, err)
+				}
+			}
+
 			id := parse.NewFailoverGroupID(subscriptionId, serverId.ResourceGroupName, serverId.ServerName, model.Name)
 
 			existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FailoverGroupFailoverId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	ServerName        string
+	FailoverGroupName string
+	FailoverName      string
+}
+
+func NewFailoverGroupFailoverID(subscriptionId, resourceGroup, serverName, failoverGroupName, failoverName string) FailoverGroupFailoverId {
+	return FailoverGroupFailoverId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		ServerName:        serverName,
+		FailoverGroupName: failoverGroupName,
+		FailoverName:      failoverName,
+	}
+}
+
+func (id FailoverGroupFailoverId) String() string {
+	segments := []string{
+		fmt.Sprintf("Failover Name %q", id.FailoverName),
+		fmt.Sprintf("Failover Group Name %q", id.FailoverGroupName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Failover Group Failover", segmentsStr)
+}
+
+func (id FailoverGroupFailoverId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/failoverGroups/%s/failover/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.FailoverGroupName, id.FailoverName)
+}
+
+// FailoverGroupFailoverID parses a FailoverGroupFailover ID into an FailoverGroupFailoverId struct
+func FailoverGroupFailoverID(input string) (*FailoverGroupFailoverId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an FailoverGroupFailover ID: %+v", input, err)
+	}
+
+	resourceId := FailoverGroupFailoverId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.FailoverGroupName, err = id.PopSegment("failoverGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.FailoverName, err = id.PopSegment("failover"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
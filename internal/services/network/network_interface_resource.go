@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	lbvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -56,7 +59,12 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			// NOTE: this is only ForceNew when moving resources between Resource Groups is disabled in the `features` block
+			"resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: resourcegroups.ValidateName,
+			},
 
 			"ip_configuration": {
 				Type:     pluginsdk.TypeList,
@@ -219,6 +227,12 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("resource_group_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return !meta.(*clients.Client).Features.ResourceGroup.MoveResourcesOnResourceGroupChange
+			}),
+		),
 	}
 
 	if !features.FourPointOhBeta() {
@@ -352,6 +366,15 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 	defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
 
+	if d.HasChange("resource_group_name") {
+		newId := commonids.NewNetworkInterfaceID(id.SubscriptionId, d.Get("resource_group_name").(string), id.NetworkInterfaceName)
+		if err := moveNetworkInterface(ctx, meta.(*clients.Client), *id, newId); err != nil {
+			return err
+		}
+		id = &newId
+		d.SetId(id.ID())
+	}
+
 	// first get the existing one so that we can pull things as needed
 	existing, err := client.Get(ctx, *id, networkinterfaces.DefaultGetOperationOptions())
 	if err != nil {
@@ -760,3 +783,38 @@ func flattenNetworkInterfaceDnsServers(input *[]string) []string {
 
 	return *input
 }
+
+// moveNetworkInterface moves the Network Interface into the Resource Group of `newId` - since Azure requires the Virtual
+// Machine and Public IPs it's associated with to be moved in the same request, an error is returned when any of these
+// aren't already within the target Resource Group
+func moveNetworkInterface(ctx context.Context, metaClient *clients.Client, id commonids.NetworkInterfaceId, newId commonids.NetworkInterfaceId) error {
+	client := metaClient.Network.NetworkInterfaces
+
+	existing, err := client.Get(ctx, id, networkinterfaces.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return resourceClient.ResourceMovedOutsideOfTerraformError(id.ID(), newId.ID())
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dependentIds := make([]string, 0)
+	if model := existing.Model; model != nil && model.Properties != nil {
+		if model.Properties.VirtualMachine != nil && model.Properties.VirtualMachine.Id != nil {
+			dependentIds = append(dependentIds, *model.Properties.VirtualMachine.Id)
+		}
+		if model.Properties.IPConfigurations != nil {
+			for _, ipConfig := range *model.Properties.IPConfigurations {
+				if ipConfig.Properties != nil && ipConfig.Properties.PublicIPAddress != nil && ipConfig.Properties.PublicIPAddress.Id != nil {
+					dependentIds = append(dependentIds, *ipConfig.Properties.PublicIPAddress.Id)
+				}
+			}
+		}
+	}
+
+	if err := metaClient.Resource.MoveResourceToResourceGroup(ctx, id.ID(), dependentIds, newId.ResourceGroupName); err != nil {
+		return fmt.Errorf("moving %s to Resource Group %q: %+v", id, newId.ResourceGroupName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatelinkservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	privateLinkServiceEndpointConnectionStatusApproved = "Approved"
+	privateLinkServiceEndpointConnectionStatusRejected = "Rejected"
+)
+
+type PrivateLinkServiceEndpointConnectionApprovalModel struct {
+	ConnectionId      string `tfschema:"connection_id"`
+	Status            string `tfschema:"status"`
+	Description       string `tfschema:"description"`
+	PrivateEndpointId string `tfschema:"private_endpoint_id"`
+}
+
+var _ sdk.ResourceWithUpdate = PrivateLinkServiceEndpointConnectionApprovalResource{}
+
+type PrivateLinkServiceEndpointConnectionApprovalResource struct{}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_private_link_service_endpoint_connection_approval"
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &PrivateLinkServiceEndpointConnectionApprovalModel{}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatelinkservices.ValidatePrivateEndpointConnectionID
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privatelinkservices.ValidatePrivateEndpointConnectionID,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  privateLinkServiceEndpointConnectionStatusApproved,
+			ValidateFunc: validation.StringInSlice([]string{
+				privateLinkServiceEndpointConnectionStatusApproved,
+				privateLinkServiceEndpointConnectionStatusRejected,
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 140),
+		},
+	}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateLinkServiceEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := privatelinkservices.ParsePrivateEndpointConnectionID(model.ConnectionId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.PrivateLinkServiceName, "azurerm_private_link_service")
+			defer locks.UnlockByName(id.PrivateLinkServiceName, "azurerm_private_link_service")
+
+			if err := updatePrivateLinkServiceEndpointConnectionState(ctx, metadata.Client.Network.PrivateLinkServices, *id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServices
+
+			id, err := privatelinkservices.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetPrivateEndpointConnection(ctx, *id, privatelinkservices.DefaultGetPrivateEndpointConnectionOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateLinkServiceEndpointConnectionApprovalModel{
+				ConnectionId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.PrivateEndpoint != nil {
+						state.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+					}
+
+					if connectionState := props.PrivateLinkServiceConnectionState; connectionState != nil {
+						state.Status = pointer.From(connectionState.Status)
+						state.Description = pointer.From(connectionState.Description)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := privatelinkservices.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateLinkServiceEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.PrivateLinkServiceName, "azurerm_private_link_service")
+			defer locks.UnlockByName(id.PrivateLinkServiceName, "azurerm_private_link_service")
+
+			return updatePrivateLinkServiceEndpointConnectionState(ctx, metadata.Client.Network.PrivateLinkServices, *id, model)
+		},
+	}
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the connection belongs to the Private Endpoint, so it's left in the state it was approved (or rejected) in
+			// rather than being removed from the Private Link Service
+			return nil
+		},
+	}
+}
+
+func updatePrivateLinkServiceEndpointConnectionState(ctx context.Context, client *privatelinkservices.PrivateLinkServicesClient, id privatelinkservices.PrivateEndpointConnectionId, model PrivateLinkServiceEndpointConnectionApprovalModel) error {
+	existing, err := client.GetPrivateEndpointConnection(ctx, id, privatelinkservices.DefaultGetPrivateEndpointConnectionOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	connectionState := existing.Model.Properties.PrivateLinkServiceConnectionState
+	if connectionState == nil {
+		connectionState = &privatelinkservices.PrivateLinkServiceConnectionState{}
+	}
+	connectionState.Status = pointer.To(model.Status)
+	connectionState.Description = pointer.To(model.Description)
+	existing.Model.Properties.PrivateLinkServiceConnectionState = connectionState
+
+	if _, err := client.UpdatePrivateEndpointConnection(ctx, id, *existing.Model); err != nil {
+		return fmt.Errorf("updating the status of %s to %q: %+v", id, model.Status, err)
+	}
+
+	return nil
+}
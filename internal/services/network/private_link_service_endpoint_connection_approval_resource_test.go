@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatelinkservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateLinkServiceEndpointConnectionApprovalResource struct{}
+
+func TestAccPrivateLinkServiceEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_endpoint_connection_approval", "test")
+	r := PrivateLinkServiceEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Approved"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("private_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Rejected"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatelinkservices.ParsePrivateEndpointConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PrivateLinkServices.GetPrivateEndpointConnection(ctx, *id, privatelinkservices.DefaultGetPrivateEndpointConnectionOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.PrivateLinkServiceConnectionState != nil {
+		return utils.Bool(pointer.From(model.Properties.PrivateLinkServiceConnectionState.Status) != "Pending"), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PrivateLinkServiceEndpointConnectionApprovalResource) basic(data acceptance.TestData, status string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_link_service_endpoint_connections" "test" {
+  service_id          = azurerm_private_endpoint.test.private_service_connection.0.private_connection_resource_id
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_link_service_endpoint_connection_approval" "test" {
+  connection_id = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_id
+  status        = %q
+  description   = "%s by Terraform"
+}
+`, PrivateEndpointResource{}.requestMessage(data, "please approve"), status, status)
+}
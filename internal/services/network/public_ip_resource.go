@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/ddosprotectionplans"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -59,7 +62,12 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			// NOTE: this is only ForceNew when moving resources between Resource Groups is disabled in the `features` block
+			"resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: resourcegroups.ValidateName,
+			},
 
 			"allocation_method": {
 				Type:     pluginsdk.TypeString,
@@ -177,6 +185,12 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("resource_group_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return !meta.(*clients.Client).Features.ResourceGroup.MoveResourcesOnResourceGroupChange
+			}),
+		),
 	}
 }
 
@@ -306,6 +320,15 @@ func resourcePublicIpUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("resource_group_name") {
+		newId := commonids.NewPublicIPAddressID(id.SubscriptionId, d.Get("resource_group_name").(string), id.PublicIPAddressesName)
+		if err := movePublicIP(ctx, meta.(*clients.Client), *id, newId); err != nil {
+			return err
+		}
+		id = &newId
+		d.SetId(id.ID())
+	}
+
 	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -467,3 +490,33 @@ func flattenPublicIpPropsIpTags(input *[]publicipaddresses.IPTag) map[string]int
 
 	return out
 }
+
+// movePublicIP moves the Public IP into the Resource Group of `newId` - since Azure requires a Public IP which is
+// associated with a Network Interface to be moved alongside it, an error is returned if it's associated with one
+func movePublicIP(ctx context.Context, metaClient *clients.Client, id commonids.PublicIPAddressId, newId commonids.PublicIPAddressId) error {
+	client := metaClient.Network.PublicIPAddresses
+
+	existing, err := client.Get(ctx, id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return resourceClient.ResourceMovedOutsideOfTerraformError(id.ID(), newId.ID())
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dependentIds := make([]string, 0)
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.IPConfiguration != nil && model.Properties.IPConfiguration.Id != nil {
+		if ipConfigurationId, err := commonids.ParseNetworkInterfaceIPConfigurationIDInsensitively(*model.Properties.IPConfiguration.Id); err == nil {
+			dependentIds = append(dependentIds, commonids.NewNetworkInterfaceID(ipConfigurationId.SubscriptionId, ipConfigurationId.ResourceGroupName, ipConfigurationId.NetworkInterfaceName).ID())
+		} else {
+			// e.g. a Public IP associated with a Load Balancer or a Virtual Network Gateway
+			dependentIds = append(dependentIds, *model.Properties.IPConfiguration.Id)
+		}
+	}
+
+	if err := metaClient.Resource.MoveResourceToResourceGroup(ctx, id.ID(), dependentIds, newId.ResourceGroupName); err != nil {
+		return fmt.Errorf("moving %s to Resource Group %q: %+v", id, newId.ResourceGroupName, err)
+	}
+
+	return nil
+}
@@ -61,6 +61,29 @@ func TestAccPublicIpStatic_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_moveResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.moveResourceGroup(data, "test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.moveResourceGroup(data, "target"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_group_name").HasValue(fmt.Sprintf("acctestRG-target-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIp_zonesSingle(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
 	r := PublicIPResource{}
@@ -482,6 +505,35 @@ resource "azurerm_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (PublicIPResource) moveResourceGroup(data acceptance.TestData, resourceGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      move_resources_on_resource_group_change = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctestRG-target-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.%[3]s.name
+  allocation_method   = "Static"
+}
+`, data.RandomInteger, data.Locations.Primary, resourceGroup)
+}
+
 func (r PublicIPResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -54,6 +54,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateLinkServiceEndpointConnectionApprovalResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
 	}
@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ManagementGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ManagementGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ManagementGroupAssignmentResource{}
+)
 
 type ManagementGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -48,6 +51,10 @@ func (r ManagementGroupAssignmentResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "management_group_id")
 }
 
+func (r ManagementGroupAssignmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
+
 func (r ManagementGroupAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
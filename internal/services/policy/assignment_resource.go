@@ -10,7 +10,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ResourceAssignmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ResourceAssignmentResource{}
+)
 
 type ResourceAssignmentResource struct {
 	base assignmentBaseResource
@@ -46,6 +49,10 @@ func (r ResourceAssignmentResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_id")
 }
 
+func (r ResourceAssignmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
+
 func (r ResourceAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
@@ -412,6 +412,7 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 		"overrides": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
+			MaxItems: 10,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*schema.Schema{
 					"selectors": {
@@ -422,8 +423,10 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 								"in": {
 									Type:     pluginsdk.TypeList,
 									Optional: true,
+									MaxItems: 50,
 									Elem: &pluginsdk.Schema{
-										Type: pluginsdk.TypeString,
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
 
@@ -438,8 +441,10 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 								"not_in": {
 									Type:     pluginsdk.TypeList,
 									Optional: true,
+									MaxItems: 50,
 									Elem: &pluginsdk.Schema{
-										Type: pluginsdk.TypeString,
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
 							},
@@ -459,6 +464,7 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 		"resource_selectors": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
+			MaxItems: 10,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*schema.Schema{
 					"name": {
@@ -475,8 +481,10 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 								"in": {
 									Type:     pluginsdk.TypeList,
 									Optional: true,
+									MaxItems: 50,
 									Elem: &pluginsdk.Schema{
-										Type: pluginsdk.TypeString,
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
 
@@ -495,8 +503,10 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 								"not_in": {
 									Type:     pluginsdk.TypeList,
 									Optional: true,
+									MaxItems: 50,
 									Elem: &pluginsdk.Schema{
-										Type: pluginsdk.TypeString,
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
 							},
@@ -518,6 +528,76 @@ func (br assignmentBaseResource) attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (br assignmentBaseResource) customizeDiffFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			for i, v := range rd.Get("overrides").([]interface{}) {
+				override, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for j, selector := range override["selectors"].([]interface{}) {
+					if err := br.validateSelector(rd, fmt.Sprintf("overrides.%d.selectors.%d", i, j), selector); err != nil {
+						return err
+					}
+				}
+			}
+
+			for i, v := range rd.Get("resource_selectors").([]interface{}) {
+				resourceSelector, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				kinds := make(map[string]bool)
+				for j, selector := range resourceSelector["selectors"].([]interface{}) {
+					key := fmt.Sprintf("resource_selectors.%d.selectors.%d", i, j)
+					if err := br.validateSelector(rd, key, selector); err != nil {
+						return err
+					}
+
+					kind := selector.(map[string]interface{})["kind"].(string)
+					if kinds[kind] {
+						return fmt.Errorf("the selector kind %q can only be specified once within `resource_selectors.%d`", kind, i)
+					}
+					kinds[kind] = true
+				}
+
+				// `resourceWithoutLocation` targets resources which don't have a location, so it can't be combined with `resourceLocation`
+				if kinds[string(policyassignments.SelectorKindResourceWithoutLocation)] && kinds[string(policyassignments.SelectorKindResourceLocation)] {
+					return fmt.Errorf("the selector kinds %q and %q cannot be used together within `resource_selectors.%d`", string(policyassignments.SelectorKindResourceWithoutLocation), string(policyassignments.SelectorKindResourceLocation), i)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateSelector ensures that exactly one of `in` or `not_in` is specified for the selector at the given key
+func (br assignmentBaseResource) validateSelector(rd *pluginsdk.ResourceDiff, key string, input interface{}) error {
+	selector, ok := input.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	// values referencing other resources may not be known until apply
+	if !rd.NewValueKnown(key+".in") || !rd.NewValueKnown(key+".not_in") {
+		return nil
+	}
+
+	in, _ := selector["in"].([]interface{})
+	notIn, _ := selector["not_in"].([]interface{})
+	if (len(in) == 0) == (len(notIn) == 0) {
+		return fmt.Errorf("exactly one of `in` or `not_in` must be specified for `%s`", key)
+	}
+
+	return nil
+}
+
 func (br assignmentBaseResource) flattenNonComplianceMessages(input *[]policyassignments.NonComplianceMessage) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ResourceGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ResourceGroupAssignmentResource{}
+)
 
 type ResourceGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -46,6 +49,10 @@ func (r ResourceGroupAssignmentResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
 
+func (r ResourceGroupAssignmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
+
 func (r ResourceGroupAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
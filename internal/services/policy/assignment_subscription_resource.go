@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = SubscriptionAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = SubscriptionAssignmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = SubscriptionAssignmentResource{}
+)
 
 type SubscriptionAssignmentResource struct {
 	base assignmentBaseResource
@@ -46,6 +49,10 @@ func (r SubscriptionAssignmentResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
 
+func (r SubscriptionAssignmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return r.base.customizeDiffFunc()
+}
+
 func (r SubscriptionAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
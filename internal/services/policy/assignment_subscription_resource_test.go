@@ -6,6 +6,7 @@ package policy_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -232,6 +233,18 @@ func TestAccSubscriptionPolicyAssignment_overridesAndResourceSelector(t *testing
 	})
 }
 
+func TestAccSubscriptionPolicyAssignment_resourceSelectorInvalidKinds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
+	r := SubscriptionAssignmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withResourceSelectorInvalidKinds(data),
+			ExpectError: regexp.MustCompile("cannot be used together"),
+		},
+	})
+}
+
 func TestAccSubscriptionPolicyAssignment_basicWithCustomPolicyComplete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_assignment", "test")
 	r := SubscriptionAssignmentTestResource{}
@@ -589,6 +602,35 @@ resource "azurerm_subscription_policy_assignment" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SubscriptionAssignmentTestResource) withResourceSelectorInvalidKinds(data acceptance.TestData) string {
+	template := r.templateWithCustomPolicy(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_subscription_policy_assignment" "test" {
+  name                 = "acctestpa-sub-%[2]d"
+  subscription_id      = data.azurerm_subscription.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+
+  resource_selectors {
+    selectors {
+      in   = ["eastus"]
+      kind = "resourceLocation"
+    }
+
+    selectors {
+      in   = ["Microsoft.Resources/subscriptions/resourceGroups"]
+      kind = "resourceWithoutLocation"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r SubscriptionAssignmentTestResource) withOverrideAndSelectorsUpdate(data acceptance.TestData) string {
 	template := r.templateWithCustomPolicy(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyExemptionScopeDiff returns a CustomizeDiff function which checks that the exempted Policy Assignment applies
+// to the scope specified in `scopeFieldName`, since otherwise the exemption would have no effect
+func policyExemptionScopeDiff(scopeFieldName string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+		if !d.NewValueKnown("policy_assignment_id") || !d.NewValueKnown(scopeFieldName) {
+			return nil
+		}
+
+		assignmentId := d.Get("policy_assignment_id").(string)
+		scope := d.Get(scopeFieldName).(string)
+		if assignmentId == "" || scope == "" {
+			return nil
+		}
+
+		return validate.PolicyAssignmentCoversScope(assignmentId, scope)
+	}
+}
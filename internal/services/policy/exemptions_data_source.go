@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExemptionsDataSource struct{}
+
+var _ sdk.DataSource = ExemptionsDataSource{}
+
+type ExemptionsDataSourceModel struct {
+	ScopeId           string                    `tfschema:"scope_id"`
+	ExpiresWithinDays int64                     `tfschema:"expires_within_days"`
+	Exemptions        []ExemptionDataSourceItem `tfschema:"exemptions"`
+}
+
+type ExemptionDataSourceItem struct {
+	Id                           string   `tfschema:"id"`
+	Name                         string   `tfschema:"name"`
+	DisplayName                  string   `tfschema:"display_name"`
+	ExemptionCategory            string   `tfschema:"exemption_category"`
+	PolicyAssignmentId           string   `tfschema:"policy_assignment_id"`
+	PolicyDefinitionReferenceIds []string `tfschema:"policy_definition_reference_ids"`
+	ExpiresOn                    string   `tfschema:"expires_on"`
+	DaysUntilExpiry              int64    `tfschema:"days_until_expiry"`
+}
+
+func (ExemptionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"expires_within_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+func (ExemptionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"exemptions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exemption_category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_assignment_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_definition_reference_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"expires_on": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"days_until_expiry": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExemptionsDataSource) ModelObject() interface{} {
+	return &ExemptionsDataSourceModel{}
+}
+
+func (ExemptionsDataSource) ResourceType() string {
+	return "azurerm_policy_exemptions"
+}
+
+func (ExemptionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ExemptionsDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope, err := parse.PolicyScopeID(config.ScopeId)
+			if err != nil {
+				return fmt.Errorf("parsing %q: %+v", config.ScopeId, err)
+			}
+
+			// the client is bound to the provider subscription, so take a copy when listing another subscription
+			client := *metadata.Client.Policy.ExemptionsClient
+
+			var iterator policy.ExemptionListResultIterator
+			switch scopeId := scope.(type) {
+			case parse.ScopeAtManagementGroup:
+				iterator, err = client.ListForManagementGroupComplete(ctx, scopeId.ManagementGroupName, "")
+			case parse.ScopeAtSubscription:
+				client.SubscriptionID = scopeId.SubscriptionId
+				iterator, err = client.ListComplete(ctx, "")
+			case parse.ScopeAtResourceGroup:
+				client.SubscriptionID = scopeId.SubscriptionId
+				iterator, err = client.ListForResourceGroupComplete(ctx, scopeId.ResourceGroup, "")
+			default:
+				return fmt.Errorf("listing Policy Exemptions is not supported at the scope %q", config.ScopeId)
+			}
+			if err != nil {
+				return fmt.Errorf("listing Policy Exemptions for %q: %+v", config.ScopeId, err)
+			}
+
+			now := time.Now().UTC()
+			filterByExpiry := !metadata.ResourceData.GetRawConfig().AsValueMap()["expires_within_days"].IsNull()
+			cutoff := now.AddDate(0, 0, int(config.ExpiresWithinDays))
+
+			config.Exemptions = make([]ExemptionDataSourceItem, 0)
+			for iterator.NotDone() {
+				item := iterator.Value()
+				if err := iterator.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing Policy Exemptions for %q: %+v", config.ScopeId, err)
+				}
+
+				exemption := ExemptionDataSourceItem{
+					Id:   pointer.From(item.ID),
+					Name: pointer.From(item.Name),
+				}
+
+				var expiresOn *time.Time
+				if props := item.ExemptionProperties; props != nil {
+					exemption.DisplayName = pointer.From(props.DisplayName)
+					exemption.ExemptionCategory = string(props.ExemptionCategory)
+					exemption.PolicyAssignmentId = pointer.From(props.PolicyAssignmentID)
+					exemption.PolicyDefinitionReferenceIds = pointer.From(props.PolicyDefinitionReferenceIds)
+					if props.ExpiresOn != nil {
+						expiresOn = pointer.To(props.ExpiresOn.Time.UTC())
+					}
+				}
+
+				if expiresOn != nil {
+					exemption.ExpiresOn = expiresOn.Format(time.RFC3339)
+					exemption.DaysUntilExpiry = int64(math.Floor(expiresOn.Sub(now).Hours() / 24))
+				}
+
+				// exemptions without an expiry never lapse, so they're only returned when no window is specified
+				if filterByExpiry && (expiresOn == nil || expiresOn.After(cutoff)) {
+					continue
+				}
+
+				config.Exemptions = append(config.Exemptions, exemption)
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Authorization/policyExemptions", config.ScopeId))
+
+			return metadata.Encode(&config)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExemptionsDataSource struct{}
+
+func TestAccDataSourcePolicyExemptions_expiresWithinDays(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_exemptions", "test")
+	d := ExemptionsDataSource{}
+	endDate := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.expiresWithinDays(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exemptions.#").Exists(),
+				check.That(data.ResourceName).Key("exemptions.0.expires_on").Exists(),
+			),
+		},
+	})
+}
+
+func (d ExemptionsDataSource) expiresWithinDays(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_exemptions" "test" {
+  scope_id            = azurerm_subscription_policy_exemption.test.subscription_id
+  expires_within_days = 2
+}
+`, SubscriptionPolicyExemptionResource{}.complete(data, endDate))
+}
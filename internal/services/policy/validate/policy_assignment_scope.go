@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+)
+
+// PolicyAssignmentCoversScope validates that the Policy Assignment `assignmentId` applies to `scope`, that is that
+// `scope` is either the scope of the Policy Assignment or a scope beneath it.
+// Policy Assignments at a Management Group scope apply to the descendants of the Management Group (including child
+// Management Groups), which can't be determined from the IDs alone - so these are presumed to apply.
+func PolicyAssignmentCoversScope(assignmentId, scope string) error {
+	id, err := parse.PolicyAssignmentID(assignmentId)
+	if err != nil {
+		return err
+	}
+
+	assignmentScope := strings.TrimSuffix(strings.ToLower(id.Scope), "/")
+	target := strings.TrimSuffix(strings.ToLower(scope), "/")
+
+	if assignmentScope == target || strings.HasPrefix(target, assignmentScope+"/") {
+		return nil
+	}
+
+	if strings.HasPrefix(assignmentScope, "/providers/microsoft.management/managementgroups/") {
+		return nil
+	}
+
+	return fmt.Errorf("the Policy Assignment %q (assigned at the scope %q) does not apply to the scope %q", id.Name, id.Scope, scope)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestPolicyAssignmentCoversScope(t *testing.T) {
+	cases := []struct {
+		AssignmentId string
+		Scope        string
+		Valid        bool
+	}{
+		{
+			// invalid assignment id
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid:        false,
+		},
+		{
+			// same subscription
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid:        true,
+		},
+		{
+			// resource group beneath the subscription, differing in case
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			Valid:        true,
+		},
+		{
+			// resource beneath the resource group
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Valid:        true,
+		},
+		{
+			// different subscription
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Valid:        false,
+		},
+		{
+			// resource group with a matching prefix
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group10",
+			Valid:        false,
+		},
+		{
+			// subscription above the resource group
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid:        false,
+		},
+		{
+			// management group above the subscription
+			AssignmentId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/providers/Microsoft.Management/managementGroups/group1",
+			Valid:        false,
+		},
+		{
+			// same management group
+			AssignmentId: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/providers/Microsoft.Management/managementGroups/group1",
+			Valid:        true,
+		},
+		{
+			// management group which may be beneath the management group
+			AssignmentId: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/providers/Microsoft.Management/managementGroups/group2",
+			Valid:        true,
+		},
+		{
+			// subscription which may be beneath the management group
+			AssignmentId: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q / %q", tc.AssignmentId, tc.Scope)
+		err := PolicyAssignmentCoversScope(tc.AssignmentId, tc.Scope)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q / %q", tc.Valid, valid, tc.AssignmentId, tc.Scope)
+		}
+	}
+}
@@ -0,0 +1,518 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	dashboardPartTypeMarkdown     = "Extensions/HubsExtension/PartType/MarkdownPart"
+	dashboardPartTypeMonitorChart = "Extensions/HubsExtension/PartType/MonitorChartPart"
+)
+
+// the Portal stores the aggregation of a metrics chart as an integer
+var dashboardMetricAggregationTypes = map[string]int{
+	"Sum":     1,
+	"Minimum": 2,
+	"Maximum": 3,
+	"Average": 4,
+	"Count":   7,
+}
+
+var dashboardWorkbookLinkRegex = regexp.MustCompile(`^\[(.*)\]\(https://portal\.azure\.com/#@/resource(/subscriptions/.+)/workbook\)$`)
+
+func portalDashboardLensSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"dashboard_properties"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"part": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"position": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"x": {
+											Type:         pluginsdk.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+
+										"y": {
+											Type:         pluginsdk.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+
+										"column_span": {
+											Type:         pluginsdk.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+
+										"row_span": {
+											Type:         pluginsdk.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+
+							"markdown": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"content": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"title": {
+											Type:     pluginsdk.TypeString,
+											Optional: true,
+										},
+
+										"subtitle": {
+											Type:     pluginsdk.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+
+							"metrics_chart": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"resource_id": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"metric_namespace": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"metric_name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"aggregation": {
+											Type:     pluginsdk.TypeString,
+											Optional: true,
+											Default:  "Average",
+											ValidateFunc: validation.StringInSlice([]string{
+												"Average",
+												"Count",
+												"Maximum",
+												"Minimum",
+												"Sum",
+											}, false),
+										},
+
+										"title": {
+											Type:     pluginsdk.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+
+							"workbook_link": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"workbook_id": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"title": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+
+							"metadata_json": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsJSON,
+								StateFunc:    utils.NormalizeJson,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPortalDashboardLenses(input []interface{}) (*dashboard.DashboardProperties, error) {
+	lenses := make(map[string]dashboard.DashboardLens)
+
+	for i, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		parts := make(map[string]dashboard.DashboardParts)
+		for j, partRaw := range raw["part"].([]interface{}) {
+			part, err := expandPortalDashboardPart(partRaw.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("expanding `part` %d of `lens` %d: %+v", j, i, err)
+			}
+			parts[strconv.Itoa(j)] = *part
+		}
+
+		lenses[strconv.Itoa(i)] = dashboard.DashboardLens{
+			Order: int64(i),
+			Parts: parts,
+		}
+	}
+
+	return &dashboard.DashboardProperties{
+		Lenses: &lenses,
+	}, nil
+}
+
+func expandPortalDashboardPart(input map[string]interface{}) (*dashboard.DashboardParts, error) {
+	output := dashboard.DashboardParts{}
+
+	if v := input["position"].([]interface{}); len(v) > 0 && v[0] != nil {
+		position := v[0].(map[string]interface{})
+		output.Position = dashboard.DashboardPartsPosition{
+			X:       int64(position["x"].(int)),
+			Y:       int64(position["y"].(int)),
+			ColSpan: int64(position["column_span"].(int)),
+			RowSpan: int64(position["row_span"].(int)),
+		}
+	}
+
+	metadataBlocks := make([]interface{}, 0)
+
+	if v := input["markdown"].([]interface{}); len(v) > 0 && v[0] != nil {
+		markdown := v[0].(map[string]interface{})
+		metadataBlocks = append(metadataBlocks, dashboardMarkdownPartMetadata(markdown["content"].(string), markdown["title"].(string), markdown["subtitle"].(string)))
+	}
+
+	if v := input["metrics_chart"].([]interface{}); len(v) > 0 && v[0] != nil {
+		chart := v[0].(map[string]interface{})
+		metadataBlocks = append(metadataBlocks, map[string]interface{}{
+			"type": dashboardPartTypeMonitorChart,
+			"inputs": []interface{}{
+				map[string]interface{}{
+					"name":       "options",
+					"isOptional": true,
+					"value": map[string]interface{}{
+						"chart": map[string]interface{}{
+							"metrics": []interface{}{
+								map[string]interface{}{
+									"resourceMetadata": map[string]interface{}{
+										"id": chart["resource_id"].(string),
+									},
+									"name":            chart["metric_name"].(string),
+									"namespace":       chart["metric_namespace"].(string),
+									"aggregationType": dashboardMetricAggregationTypes[chart["aggregation"].(string)],
+								},
+							},
+							"title": chart["title"].(string),
+						},
+					},
+				},
+			},
+			"settings": map[string]interface{}{},
+		})
+	}
+
+	if v := input["workbook_link"].([]interface{}); len(v) > 0 && v[0] != nil {
+		workbook := v[0].(map[string]interface{})
+		content := fmt.Sprintf("[%s](https://portal.azure.com/#@/resource%s/workbook)", workbook["title"].(string), workbook["workbook_id"].(string))
+		metadataBlocks = append(metadataBlocks, dashboardMarkdownPartMetadata(content, "", ""))
+	}
+
+	if v := input["metadata_json"].(string); v != "" {
+		var metadata interface{}
+		if err := json.Unmarshal([]byte(v), &metadata); err != nil {
+			return nil, fmt.Errorf("parsing `metadata_json`: %+v", err)
+		}
+		metadataBlocks = append(metadataBlocks, metadata)
+	}
+
+	if len(metadataBlocks) != 1 {
+		return nil, fmt.Errorf("exactly one of `markdown`, `metrics_chart`, `workbook_link` or `metadata_json` must be specified")
+	}
+	output.Metadata = &metadataBlocks[0]
+
+	return &output, nil
+}
+
+func dashboardMarkdownPartMetadata(content, title, subtitle string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":   dashboardPartTypeMarkdown,
+		"inputs": []interface{}{},
+		"settings": map[string]interface{}{
+			"content": map[string]interface{}{
+				"settings": map[string]interface{}{
+					"content":  content,
+					"title":    title,
+					"subtitle": subtitle,
+				},
+			},
+		},
+	}
+}
+
+func flattenPortalDashboardLenses(input *dashboard.DashboardProperties) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil || input.Lenses == nil {
+		return output, nil
+	}
+
+	for _, lens := range sortedPortalDashboardLenses(*input.Lenses) {
+		parts := make([]interface{}, 0)
+		for _, part := range sortedPortalDashboardParts(lens.Parts) {
+			flattened, err := flattenPortalDashboardPart(part)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, flattened)
+		}
+
+		output = append(output, map[string]interface{}{
+			"part": parts,
+		})
+	}
+
+	return output, nil
+}
+
+func flattenPortalDashboardPart(input dashboard.DashboardParts) (map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"position": []interface{}{
+			map[string]interface{}{
+				"x":           int(input.Position.X),
+				"y":           int(input.Position.Y),
+				"column_span": int(input.Position.ColSpan),
+				"row_span":    int(input.Position.RowSpan),
+			},
+		},
+		"markdown":      []interface{}{},
+		"metrics_chart": []interface{}{},
+		"workbook_link": []interface{}{},
+		"metadata_json": "",
+	}
+
+	if input.Metadata == nil {
+		return output, nil
+	}
+
+	// round-trip through JSON so that the metadata can be inspected regardless of how it was decoded
+	raw, err := json.Marshal(*input.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling part metadata: %+v", err)
+	}
+
+	var typed struct {
+		Type     string `json:"type"`
+		Settings struct {
+			Content struct {
+				Settings struct {
+					Content  string `json:"content"`
+					Title    string `json:"title"`
+					Subtitle string `json:"subtitle"`
+				} `json:"settings"`
+			} `json:"content"`
+		} `json:"settings"`
+		Inputs []struct {
+			Name  string `json:"name"`
+			Value struct {
+				Chart struct {
+					Title   string `json:"title"`
+					Metrics []struct {
+						ResourceMetadata struct {
+							Id string `json:"id"`
+						} `json:"resourceMetadata"`
+						Name            string `json:"name"`
+						Namespace       string `json:"namespace"`
+						AggregationType int    `json:"aggregationType"`
+					} `json:"metrics"`
+				} `json:"chart"`
+			} `json:"value"`
+		} `json:"inputs"`
+	}
+	if err := json.Unmarshal(raw, &typed); err != nil {
+		output["metadata_json"] = string(raw)
+		return output, nil
+	}
+
+	switch typed.Type {
+	case dashboardPartTypeMarkdown:
+		settings := typed.Settings.Content.Settings
+		if matches := dashboardWorkbookLinkRegex.FindStringSubmatch(settings.Content); len(matches) == 3 && settings.Title == "" && settings.Subtitle == "" {
+			output["workbook_link"] = []interface{}{
+				map[string]interface{}{
+					"title":       matches[1],
+					"workbook_id": matches[2],
+				},
+			}
+			return output, nil
+		}
+
+		if settings.Content != "" {
+			output["markdown"] = []interface{}{
+				map[string]interface{}{
+					"content":  settings.Content,
+					"title":    settings.Title,
+					"subtitle": settings.Subtitle,
+				},
+			}
+			return output, nil
+		}
+
+	case dashboardPartTypeMonitorChart:
+		if len(typed.Inputs) == 1 && typed.Inputs[0].Name == "options" && len(typed.Inputs[0].Value.Chart.Metrics) == 1 {
+			chart := typed.Inputs[0].Value.Chart
+			metric := chart.Metrics[0]
+
+			aggregation := ""
+			for k, v := range dashboardMetricAggregationTypes {
+				if v == metric.AggregationType {
+					aggregation = k
+				}
+			}
+
+			if aggregation != "" {
+				output["metrics_chart"] = []interface{}{
+					map[string]interface{}{
+						"resource_id":      metric.ResourceMetadata.Id,
+						"metric_namespace": metric.Namespace,
+						"metric_name":      metric.Name,
+						"aggregation":      aggregation,
+						"title":            chart.Title,
+					},
+				}
+				return output, nil
+			}
+		}
+	}
+
+	// anything which can't be represented by a typed block is exposed through the escape hatch
+	output["metadata_json"] = string(raw)
+	return output, nil
+}
+
+func sortedPortalDashboardLenses(input map[string]dashboard.DashboardLens) []dashboard.DashboardLens {
+	output := make([]dashboard.DashboardLens, 0, len(input))
+	for _, lens := range input {
+		output = append(output, lens)
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].Order < output[j].Order
+	})
+
+	return output
+}
+
+// sortedPortalDashboardParts orders the parts of a lens by their position on the grid (top to bottom, then left to
+// right) - since the Portal is free to re-key the parts when a dashboard is saved, the keys themselves carry no meaning
+func sortedPortalDashboardParts(input map[string]dashboard.DashboardParts) []dashboard.DashboardParts {
+	output := make([]dashboard.DashboardParts, 0, len(input))
+	for _, part := range input {
+		output = append(output, part)
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		if output[i].Position.Y != output[j].Position.Y {
+			return output[i].Position.Y < output[j].Position.Y
+		}
+		return output[i].Position.X < output[j].Position.X
+	})
+
+	return output
+}
+
+// normalizePortalDashboardProperties re-keys the lenses and parts within the Dashboard Properties so that two
+// documents which differ only in the keys or ordering of their lenses/parts produce the same output
+func normalizePortalDashboardProperties(input string) (string, error) {
+	var properties dashboard.DashboardProperties
+	if err := json.Unmarshal([]byte(input), &properties); err != nil {
+		return "", err
+	}
+
+	if properties.Lenses != nil {
+		lenses := make(map[string]dashboard.DashboardLens)
+		for i, lens := range sortedPortalDashboardLenses(*properties.Lenses) {
+			parts := make(map[string]dashboard.DashboardParts)
+			for j, part := range sortedPortalDashboardParts(lens.Parts) {
+				parts[strconv.Itoa(j)] = part
+			}
+			lens.Order = int64(i)
+			lens.Parts = parts
+			lenses[strconv.Itoa(i)] = lens
+		}
+		properties.Lenses = &lenses
+	}
+
+	output, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func portalDashboardPropertiesDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldNormalized, err := normalizePortalDashboardProperties(old)
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizePortalDashboardProperties(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
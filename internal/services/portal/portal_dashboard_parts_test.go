@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPortalDashboardPropertiesDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "identical",
+			Old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			New:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			Suppress: true,
+		},
+		{
+			Name:     "parts re-keyed",
+			Old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}},"1":{"position":{"x":2,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			New:      `{"lenses":{"0":{"order":0,"parts":{"1":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}},"0":{"position":{"x":2,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			Suppress: true,
+		},
+		{
+			Name:     "lenses re-keyed",
+			Old:      `{"lenses":{"a":{"order":0,"parts":{}},"b":{"order":1,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			New:      `{"lenses":{"0":{"order":0,"parts":{}},"1":{"order":1,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			Suppress: true,
+		},
+		{
+			Name:     "part moved",
+			Old:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2}}}}}}`,
+			New:      `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":2,"colSpan":2,"rowSpan":2}}}}}}`,
+			Suppress: false,
+		},
+		{
+			Name:     "invalid json",
+			Old:      `{"lenses":{}}`,
+			New:      `{"lenses":`,
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := portalDashboardPropertiesDiffSuppress("dashboard_properties", tc.Old, tc.New, nil); actual != tc.Suppress {
+				t.Fatalf("expected %t but got %t", tc.Suppress, actual)
+			}
+		})
+	}
+}
+
+func TestPortalDashboardLensesRoundTrip(t *testing.T) {
+	position := []interface{}{
+		map[string]interface{}{
+			"x":           0,
+			"y":           0,
+			"column_span": 6,
+			"row_span":    4,
+		},
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"part": []interface{}{
+				map[string]interface{}{
+					"position": position,
+					"markdown": []interface{}{
+						map[string]interface{}{
+							"content":  "# Hello",
+							"title":    "Title",
+							"subtitle": "",
+						},
+					},
+					"metrics_chart": []interface{}{},
+					"workbook_link": []interface{}{},
+					"metadata_json": "",
+				},
+				map[string]interface{}{
+					"position": []interface{}{
+						map[string]interface{}{
+							"x":           6,
+							"y":           0,
+							"column_span": 6,
+							"row_span":    4,
+						},
+					},
+					"markdown": []interface{}{},
+					"metrics_chart": []interface{}{
+						map[string]interface{}{
+							"resource_id":      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+							"metric_namespace": "microsoft.storage/storageaccounts",
+							"metric_name":      "Transactions",
+							"aggregation":      "Sum",
+							"title":            "Transactions",
+						},
+					},
+					"workbook_link": []interface{}{},
+					"metadata_json": "",
+				},
+				map[string]interface{}{
+					"position": []interface{}{
+						map[string]interface{}{
+							"x":           0,
+							"y":           4,
+							"column_span": 6,
+							"row_span":    2,
+						},
+					},
+					"markdown":      []interface{}{},
+					"metrics_chart": []interface{}{},
+					"workbook_link": []interface{}{
+						map[string]interface{}{
+							"workbook_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Insights/workbooks/00000000-0000-0000-0000-000000000000",
+							"title":       "Workbook",
+						},
+					},
+					"metadata_json": "",
+				},
+			},
+		},
+	}
+
+	expanded, err := expandPortalDashboardLenses(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	flattened, err := flattenPortalDashboardLenses(expanded)
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+
+	if !reflect.DeepEqual(input, flattened) {
+		t.Fatalf("expected %+v but got %+v", input, flattened)
+	}
+}
+
+func TestPortalDashboardPartRequiresExactlyOneType(t *testing.T) {
+	input := map[string]interface{}{
+		"position":      []interface{}{},
+		"markdown":      []interface{}{},
+		"metrics_chart": []interface{}{},
+		"workbook_link": []interface{}{},
+		"metadata_json": "",
+	}
+
+	if _, err := expandPortalDashboardPart(input); err == nil {
+		t.Fatalf("expected an error when no part type was specified")
+	}
+}
@@ -4,6 +4,7 @@
 package portal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -35,6 +36,16 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// when the dashboard is described using `lens` blocks the JSON representation is derived from them
+				if d.HasChange("lens") && len(d.Get("lens").([]interface{})) > 0 {
+					return d.SetNewComputed("dashboard_properties")
+				}
+				return nil
+			},
+		),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -57,11 +68,16 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 
 			"dashboard_properties": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DashboardProperties,
-				StateFunc:    utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"dashboard_properties", "lens"},
+				ValidateFunc:     validate.DashboardProperties,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: portalDashboardPropertiesDiffSuppress,
 			},
+
+			"lens": portalDashboardLensSchema(),
 		},
 	}
 }
@@ -91,15 +107,23 @@ func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	var dashboardProperties dashboard.DashboardProperties
+	if v, ok := d.GetOk("lens"); ok {
+		dashboardProperties, err := expandPortalDashboardLenses(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `lens`: %+v", err)
+		}
+		props.Properties = dashboardProperties
+	} else {
+		var dashboardProperties dashboard.DashboardProperties
+
+		dashboardPropsRaw := d.Get("dashboard_properties").(string)
+		if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
+			return fmt.Errorf("parsing JSON: %+v", err)
+		}
 
-	dashboardPropsRaw := d.Get("dashboard_properties").(string)
-	if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
-		return fmt.Errorf("parsing JSON: %+v", err)
+		props.Properties = &dashboardProperties
 	}
 
-	props.Properties = &dashboardProperties
-
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s %+v", id, err)
 	}
@@ -140,6 +164,17 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing JSON for Dashboard Properties: %+v", err)
 			}
 			d.Set("dashboard_properties", string(v))
+
+			// the structured `lens` blocks are only populated when they're used, otherwise `dashboard_properties` is authoritative
+			if len(d.Get("lens").([]interface{})) > 0 {
+				lenses, err := flattenPortalDashboardLenses(props)
+				if err != nil {
+					return fmt.Errorf("flattening `lens`: %+v", err)
+				}
+				if err := d.Set("lens", lenses); err != nil {
+					return fmt.Errorf("setting `lens`: %+v", err)
+				}
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
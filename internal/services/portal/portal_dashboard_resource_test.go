@@ -60,6 +60,28 @@ func TestAccPortalDashboard_hiddenTitle(t *testing.T) {
 	})
 }
 
+func TestAccPortalDashboard_lens(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.lens(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dashboard_properties").Exists(),
+			),
+		},
+		data.ImportStep("lens"),
+		{
+			Config: r.lensUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("lens"),
+	})
+}
+
 func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dashboard.ParseDashboardID(state.ID)
 	if err != nil {
@@ -207,3 +229,113 @@ DASH
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (PortalDashboardResource) lens(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    part {
+      position {
+        x           = 0
+        y           = 0
+        column_span = 6
+        row_span    = 2
+      }
+
+      markdown {
+        title   = "Acceptance Test"
+        content = "## This is only a test :)"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (PortalDashboardResource) lensUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    part {
+      position {
+        x           = 0
+        y           = 0
+        column_span = 6
+        row_span    = 2
+      }
+
+      markdown {
+        title    = "Acceptance Test"
+        subtitle = "Updated"
+        content  = "## This is only a test :)"
+      }
+    }
+
+    part {
+      position {
+        x           = 6
+        y           = 0
+        column_span = 6
+        row_span    = 4
+      }
+
+      metrics_chart {
+        title            = "Resource Group Deployments"
+        resource_id      = azurerm_resource_group.test.id
+        metric_namespace = "microsoft.resources/subscriptions"
+        metric_name      = "Latency"
+        aggregation      = "Average"
+      }
+    }
+
+    part {
+      position {
+        x           = 0
+        y           = 2
+        column_span = 6
+        row_span    = 2
+      }
+
+      metadata_json = jsonencode({
+        type   = "Extensions/HubsExtension/PartType/ClockPart"
+        inputs = []
+        settings = {
+          content = {
+            settings = {
+              timezoneId = "UTC"
+              timeFormat = "HH:mm"
+            }
+          }
+        }
+      })
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -20,6 +20,8 @@ import (
 	flexibleserveradministrators "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/administrators"
 	flexibleserverdatabases "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/databases"
 	flexibleserverfirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/firewallrules"
+	flexibleserverbackups "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/backups"
+	flexibleserverlongtermretentionbackups "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/longtermretentionbackup"
 	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -33,6 +35,8 @@ type Client struct {
 	FlexibleServerFirewallRuleClient    *flexibleserverfirewallrules.FirewallRulesClient
 	FlexibleServerDatabaseClient        *flexibleserverdatabases.DatabasesClient
 	FlexibleServerAdministratorsClient  *flexibleserveradministrators.AdministratorsClient
+	FlexibleServerBackupsClient         *flexibleserverbackups.BackupsClient
+	FlexibleServerLTRBackupsClient      *flexibleserverlongtermretentionbackups.LongTermRetentionBackupClient
 	ServersClient                       *servers.ServersClient
 	ServerRestartClient                 *serverrestart.ServerRestartClient
 	ServerKeysClient                    *serverkeys.ServerKeysClient
@@ -133,6 +137,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(flexibleServerAdministratorsClient.Client, o.Authorizers.ResourceManager)
 
+	flexibleServerBackupsClient, err := flexibleserverbackups.NewBackupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FlexibleServerBackups client: %+v", err)
+	}
+	o.Configure(flexibleServerBackupsClient.Client, o.Authorizers.ResourceManager)
+
+	flexibleServerLTRBackupsClient, err := flexibleserverlongtermretentionbackups.NewLongTermRetentionBackupClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FlexibleServerLongTermRetentionBackup client: %+v", err)
+	}
+	o.Configure(flexibleServerLTRBackupsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ConfigurationsClient:                configurationsClient,
 		DatabasesClient:                     databasesClient,
@@ -143,6 +159,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FlexibleServerFirewallRuleClient:    flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        flexibleServerDatabaseClient,
 		FlexibleServerAdministratorsClient:  flexibleServerAdministratorsClient,
+		FlexibleServerBackupsClient:         flexibleServerBackupsClient,
+		FlexibleServerLTRBackupsClient:      flexibleServerLTRBackupsClient,
 		ServersClient:                       serversClient,
 		ServerKeysClient:                    serverKeysClient,
 		ServerSecurityAlertPoliciesClient:   serverSecurityAlertPoliciesClient,
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/longtermretentionbackup"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePostgresqlFlexibleServerLongTermRetentionBackup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePostgresqlFlexibleServerLongTermRetentionBackupCreate,
+		Read:   resourcePostgresqlFlexibleServerLongTermRetentionBackupRead,
+		Delete: resourcePostgresqlFlexibleServerLongTermRetentionBackupDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := longtermretentionbackup.ParseLtrBackupOperationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: longtermretentionbackup.ValidateFlexibleServerID,
+			},
+
+			"storage_container_sas_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"start_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"end_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"datasource_size_in_bytes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"data_transferred_in_bytes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePostgresqlFlexibleServerLongTermRetentionBackupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerLTRBackupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := longtermretentionbackup.ParseFlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := longtermretentionbackup.NewLtrBackupOperationID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName, d.Get("name").(string))
+
+	existing, err := client.LtrBackupOperationsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_postgresql_flexible_server_long_term_retention_backup", id.ID())
+	}
+
+	backupSettings := longtermretentionbackup.BackupSettings{
+		BackupName: id.LtrBackupOperationName,
+	}
+
+	// the pre-backup validates that the server is able to take a long term retention backup before it's started
+	if _, err := client.FlexibleServerTriggerLtrPreBackup(ctx, *serverId, longtermretentionbackup.BackupRequestBase{BackupSettings: backupSettings}); err != nil {
+		return fmt.Errorf("validating %s: %+v", id, err)
+	}
+
+	parameters := longtermretentionbackup.LtrBackupRequest{
+		BackupSettings: backupSettings,
+		TargetDetails: longtermretentionbackup.BackupStoreDetails{
+			SasUriList: []string{
+				d.Get("storage_container_sas_url").(string),
+			},
+		},
+	}
+
+	if err := client.FlexibleServerStartLtrBackupThenPoll(ctx, *serverId, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePostgresqlFlexibleServerLongTermRetentionBackupRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerLongTermRetentionBackupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerLTRBackupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := longtermretentionbackup.ParseLtrBackupOperationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.LtrBackupOperationsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LtrBackupOperationName)
+	d.Set("server_id", longtermretentionbackup.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("status", string(props.Status))
+			d.Set("start_time", props.StartTime)
+			d.Set("end_time", pointer.From(props.EndTime))
+			d.Set("datasource_size_in_bytes", pointer.From(props.DatasourceSizeInBytes))
+			d.Set("data_transferred_in_bytes", pointer.From(props.DataTransferredInBytes))
+		}
+	}
+
+	return nil
+}
+
+func resourcePostgresqlFlexibleServerLongTermRetentionBackupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	// the backup is stored in the Storage Container which it was taken into and the backup operation
+	// can't be deleted, so this is removed from the state and the backup should be removed from the
+	// Storage Container once it's no longer needed
+	log.Printf("[DEBUG] removing %s from the state - the backup is retained in the Storage Container", d.Id())
+	return nil
+}
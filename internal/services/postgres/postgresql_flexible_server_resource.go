@@ -428,11 +428,66 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			}
 
 			return nil
-		},
+		}, postgresqlFlexibleServerPointInTimeRestoreCustomizeDiff,
 		),
 	}
 }
 
+// postgresqlFlexibleServerPointInTimeRestoreCustomizeDiff validates at plan time that the point in time to restore
+// from is within the backup retention window of the source server, rather than failing once the restore is started
+func postgresqlFlexibleServerPointInTimeRestoreCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if diff.Get("create_mode").(string) != string(servers.CreateModePointInTimeRestore) || !diff.HasChange("point_in_time_restore_time_in_utc") {
+		return nil
+	}
+
+	// the values aren't known yet when they're referencing resources which are being created
+	if !diff.NewValueKnown("point_in_time_restore_time_in_utc") || !diff.NewValueKnown("source_server_id") {
+		return nil
+	}
+
+	pointInTimeUTC := diff.Get("point_in_time_restore_time_in_utc").(string)
+	if pointInTimeUTC == "" {
+		return nil
+	}
+
+	restoreTime, err := time.Parse(time.RFC3339, pointInTimeUTC)
+	if err != nil {
+		return fmt.Errorf("unable to parse `point_in_time_restore_time_in_utc` value")
+	}
+
+	if restoreTime.After(time.Now()) {
+		return fmt.Errorf("`point_in_time_restore_time_in_utc` (%s) must not be in the future", pointInTimeUTC)
+	}
+
+	sourceServerId, err := servers.ParseFlexibleServerID(diff.Get("source_server_id").(string))
+	if err != nil {
+		// `source_server_id` is validated to be required when the server is created
+		return nil
+	}
+
+	client := v.(*clients.Client).Postgres.FlexibleServersClient
+	resp, err := client.Get(ctx, *sourceServerId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("the source %s was not found", sourceServerId)
+		}
+		return fmt.Errorf("retrieving the source %s: %+v", sourceServerId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Backup != nil {
+		earliestRestoreTime, err := model.Properties.Backup.GetEarliestRestoreDateAsTime()
+		if err != nil {
+			return fmt.Errorf("parsing the earliest restore date of the source %s: %+v", sourceServerId, err)
+		}
+
+		if earliestRestoreTime != nil && restoreTime.Before(*earliestRestoreTime) {
+			return fmt.Errorf("`point_in_time_restore_time_in_utc` (%s) must be within the backup retention window of the source %s, the earliest restore point is %s", pointInTimeUTC, sourceServerId, earliestRestoreTime.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
 func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
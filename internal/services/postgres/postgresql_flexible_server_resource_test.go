@@ -220,6 +220,24 @@ func TestAccPostgresqlFlexibleServer_pointInTimeRestore(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlFlexibleServer_pointInTimeRestoreOutsideRetention(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config:      r.pointInTimeRestoreAt(data, time.Now().Add(-48*time.Hour)),
+			ExpectError: regexp.MustCompile("must be within the backup retention window"),
+		},
+	})
+}
+
 func TestAccPostgresqlFlexibleServer_failover(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
 	r := PostgresqlFlexibleServerResource{}
@@ -1018,6 +1036,22 @@ resource "azurerm_postgresql_flexible_server" "pitr" {
 `, r.basic(data), data.RandomInteger, time.Now().Add(time.Duration(15)*time.Minute).UTC().Format(time.RFC3339))
 }
 
+func (r PostgresqlFlexibleServerResource) pointInTimeRestoreAt(data acceptance.TestData, restoreTime time.Time) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "pitr" {
+  name                              = "acctest-fs-pitr-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  create_mode                       = "PointInTimeRestore"
+  source_server_id                  = azurerm_postgresql_flexible_server.test.id
+  zone                              = "1"
+  point_in_time_restore_time_in_utc = "%s"
+}
+`, r.basic(data), data.RandomInteger, restoreTime.UTC().Format(time.RFC3339))
+}
+
 func (r PostgresqlFlexibleServerResource) failover(data acceptance.TestData, primaryZone string, standbyZone string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/backups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourcePostgresqlFlexibleServerRestorePoints() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePostgresqlFlexibleServerRestorePointsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: servers.ValidateFlexibleServerID,
+			},
+
+			"earliest_restore_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"backup_retention_days": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"geo_redundant_backup_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"backup": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"completed_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePostgresqlFlexibleServerRestorePointsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	serversClient := meta.(*clients.Client).Postgres.FlexibleServersClient
+	backupsClient := meta.(*clients.Client).Postgres.FlexibleServerBackupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := serversClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	backupsServerId := backups.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+	backupsResp, err := backupsClient.ListByServerComplete(ctx, backupsServerId)
+	if err != nil {
+		return fmt.Errorf("listing backups for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("server_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil && props.Backup != nil {
+			d.Set("earliest_restore_time", pointer.From(props.Backup.EarliestRestoreDate))
+			d.Set("backup_retention_days", pointer.From(props.Backup.BackupRetentionDays))
+
+			// geo-restores are made from the geo-redundant backups, which are only available when this is enabled
+			d.Set("geo_redundant_backup_enabled", pointer.From(props.Backup.GeoRedundantBackup) == servers.GeoRedundantBackupEnumEnabled)
+		}
+	}
+
+	if err := d.Set("backup", flattenPostgresqlFlexibleServerBackups(backupsResp.Items)); err != nil {
+		return fmt.Errorf("setting `backup`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenPostgresqlFlexibleServerBackups(input []backups.ServerBackup) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		backupType := ""
+		source := ""
+		completedTime := ""
+		if props := item.Properties; props != nil {
+			backupType = string(pointer.From(props.BackupType))
+			source = pointer.From(props.Source)
+			completedTime = pointer.From(props.CompletedTime)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":           pointer.From(item.Name),
+			"type":           backupType,
+			"source":         source,
+			"completed_time": completedTime,
+		})
+	}
+
+	return output
+}
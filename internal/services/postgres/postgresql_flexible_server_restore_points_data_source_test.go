@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PostgresqlFlexibleServerRestorePointsDataSource struct{}
+
+func TestAccDataSourcePostgresqlFlexibleServerRestorePoints_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_postgresql_flexible_server_restore_points", "test")
+	r := PostgresqlFlexibleServerRestorePointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("earliest_restore_time").Exists(),
+				check.That(data.ResourceName).Key("backup_retention_days").Exists(),
+				check.That(data.ResourceName).Key("geo_redundant_backup_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("location").Exists(),
+			),
+		},
+	})
+}
+
+func (PostgresqlFlexibleServerRestorePointsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_postgresql_flexible_server_restore_points" "test" {
+  server_id = azurerm_postgresql_flexible_server.test.id
+}
+`, PostgresqlFlexibleServerResource{}.basic(data))
+}
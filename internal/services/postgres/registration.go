@@ -31,8 +31,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_postgresql_server":          dataSourcePostgreSqlServer(),
-		"azurerm_postgresql_flexible_server": dataSourcePostgresqlFlexibleServer(),
+		"azurerm_postgresql_server":                         dataSourcePostgreSqlServer(),
+		"azurerm_postgresql_flexible_server":                dataSourcePostgresqlFlexibleServer(),
+		"azurerm_postgresql_flexible_server_restore_points": dataSourcePostgresqlFlexibleServerRestorePoints(),
 	}
 }
 
@@ -51,5 +52,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_postgresql_flexible_server_configuration":                  resourcePostgresqlFlexibleServerConfiguration(),
 		"azurerm_postgresql_flexible_server_database":                       resourcePostgresqlFlexibleServerDatabase(),
 		"azurerm_postgresql_flexible_server_active_directory_administrator": resourcePostgresqlFlexibleServerAdministrator(),
+		"azurerm_postgresql_flexible_server_long_term_retention_backup":     resourcePostgresqlFlexibleServerLongTermRetentionBackup(),
 	}
 }
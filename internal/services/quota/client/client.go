@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	quotaV20230201 "github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type AutoClient struct {
+	V20230201 quotaV20230201.Client
+}
+
+func NewClient(o *common.ClientOptions) (*AutoClient, error) {
+	v20230201Client, err := quotaV20230201.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building client for quota V20230201: %+v", err)
+	}
+
+	return &AutoClient{
+		V20230201: *v20230201Client,
+	}, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type QuotaResource struct{}
+
+type QuotaModel struct {
+	Name             string `tfschema:"name"`
+	ResourceProvider string `tfschema:"resource_provider"`
+	Location         string `tfschema:"location"`
+	Limit            int64  `tfschema:"limit"`
+	ResourceType     string `tfschema:"resource_type"`
+
+	DisplayName string `tfschema:"display_name"`
+	Unit        string `tfschema:"unit"`
+}
+
+var _ sdk.ResourceWithUpdate = QuotaResource{}
+
+func (r QuotaResource) ModelObject() interface{} {
+	return &QuotaModel{}
+}
+
+func (r QuotaResource) ResourceType() string {
+	return "azurerm_quota"
+}
+
+func (r QuotaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return quotainformation.ValidateScopedQuotaID
+}
+
+func (r QuotaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_provider": resourceProviderSchema(),
+
+		"location": commonschema.Location(),
+
+		"limit": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r QuotaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"unit": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r QuotaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.V20230201.QuotaInformation
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config QuotaModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := newQuotaScope(subscriptionId, config.ResourceProvider, config.Location)
+			id := quotainformation.NewScopedQuotaID(scope.ID(), config.Name)
+
+			// a Quota always exists with a default limit, so rather than checking for an existing resource this
+			// ensures the Quota is one which can be requested, before requesting the limit is changed
+			existing, err := client.QuotaGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - check the `name` is a Quota supported for this `resource_provider` and `location`", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if model := existing.Model; model != nil && model.Properties != nil && !pointer.From(model.Properties.IsQuotaApplicable) {
+				return fmt.Errorf("the limit of %s can't be requested to be changed", id)
+			}
+
+			if err := client.QuotaCreateOrUpdateThenPoll(ctx, id, expandQuotaLimit(config)); err != nil {
+				return fmt.Errorf("requesting the limit of %s is changed to %d: %+v", id, config.Limit, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.V20230201.QuotaInformation
+
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			scope, err := parseQuotaScope(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.QuotaGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := QuotaModel{
+				Name:             id.QuotaName,
+				ResourceProvider: scope.ResourceProvider,
+				Location:         location.Normalize(scope.Location),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Limit = flattenQuotaLimit(props.Limit)
+					state.ResourceType = pointer.From(props.ResourceType)
+					state.Unit = pointer.From(props.Unit)
+
+					if name := props.Name; name != nil {
+						state.DisplayName = pointer.From(name.LocalizedValue)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r QuotaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.V20230201.QuotaInformation
+
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config QuotaModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("limit") {
+				if err := client.QuotaUpdateThenPoll(ctx, *id, expandQuotaLimit(config)); err != nil {
+					return fmt.Errorf("requesting the limit of %s is changed to %d: %+v", *id, config.Limit, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a Quota can't be deleted and decreasing the limit isn't supported for all Quotas, so the current limit is retained
+			log.Printf("[DEBUG] %s can't be deleted and is only being removed from the state", *id)
+
+			return nil
+		},
+	}
+}
+
+func expandQuotaLimit(input QuotaModel) quotainformation.CurrentQuotaLimitBase {
+	props := quotainformation.QuotaProperties{
+		Limit: quotainformation.LimitObject{
+			Value: input.Limit,
+		},
+		Name: &quotainformation.ResourceName{
+			Value: pointer.To(input.Name),
+		},
+	}
+
+	if input.ResourceType != "" {
+		props.ResourceType = pointer.To(input.ResourceType)
+	}
+
+	return quotainformation.CurrentQuotaLimitBase{
+		Properties: &props,
+	}
+}
+
+func flattenQuotaLimit(input quotainformation.LimitJsonObject) int64 {
+	if v, ok := input.(quotainformation.LimitObject); ok {
+		return v.Value
+	}
+
+	return 0
+}
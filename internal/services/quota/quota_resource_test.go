@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type QuotaResource struct{}
+
+func TestAccQuota_compute(t *testing.T) {
+	// the limits of a Quota can't be decreased again once they've been increased, so this has to be explicitly opted into
+	if os.Getenv("ARM_TEST_QUOTA") == "" {
+		t.Skip("skipping tests - ARM_TEST_QUOTA was not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_quota", "test")
+	r := QuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.compute(data, 12),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("12"),
+				check.That(data.ResourceName).Key("unit").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.compute(data, 16),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("16"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (QuotaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := quotainformation.ParseScopedQuotaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Quota.V20230201.QuotaInformation.QuotaGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (QuotaResource) compute(data acceptance.TestData, limit int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_quota" "test" {
+  name              = "standardDSv3Family"
+  resource_provider = "Microsoft.Compute"
+  location          = %q
+  limit             = %d
+}
+`, data.Locations.Primary, limit)
+}
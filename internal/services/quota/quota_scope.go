@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// quotaScope is the Resource Provider and Location within a Subscription which Quotas are managed for, e.g.
+// `/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute/locations/westeurope`
+type quotaScope struct {
+	SubscriptionId   string
+	ResourceProvider string
+	Location         string
+}
+
+func newQuotaScope(subscriptionId, resourceProvider, locationName string) quotaScope {
+	return quotaScope{
+		SubscriptionId:   subscriptionId,
+		ResourceProvider: resourceProvider,
+		Location:         location.Normalize(locationName),
+	}
+}
+
+func (s quotaScope) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/providers/%s/locations/%s", s.SubscriptionId, s.ResourceProvider, s.Location)
+}
+
+func (s quotaScope) ScopeId() commonids.ScopeId {
+	return commonids.NewScopeID(s.ID())
+}
+
+func parseQuotaScope(input string) (*quotaScope, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 6 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "providers") || !strings.EqualFold(segments[4], "locations") {
+		return nil, fmt.Errorf("expected a Quota scope in the format `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}` but got %q", input)
+	}
+
+	for _, v := range []int{1, 3, 5} {
+		if segments[v] == "" {
+			return nil, fmt.Errorf("expected a Quota scope in the format `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}` but got %q", input)
+		}
+	}
+
+	scope := newQuotaScope(segments[1], segments[3], segments[5])
+	return &scope, nil
+}
+
+// resourceProviderSchema returns the schema for the Resource Providers which the Quota API supports managing limits for
+func resourceProviderSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"Microsoft.Compute",
+			"Microsoft.MachineLearningServices",
+			"Microsoft.Network",
+		}, false),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"testing"
+)
+
+func TestParseQuotaScope(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *quotaScope
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// missing location
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute",
+		},
+		{
+			// empty location
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/locations/",
+		},
+		{
+			// quota rather than scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/locations/westeurope/providers/Microsoft.Quota/quotas/standardDSv3Family",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/locations/westeurope",
+			Expected: &quotaScope{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceProvider: "Microsoft.Compute",
+				Location:         "westeurope",
+			},
+		},
+		{
+			// location is normalized
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/locations/West Europe",
+			Expected: &quotaScope{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceProvider: "Microsoft.Network",
+				Location:         "westeurope",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseQuotaScope(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
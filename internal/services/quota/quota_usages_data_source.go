@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type QuotaUsagesDataSource struct{}
+
+type QuotaUsagesDataSourceModel struct {
+	ResourceProvider string       `tfschema:"resource_provider"`
+	Location         string       `tfschema:"location"`
+	Usages           []QuotaUsage `tfschema:"usages"`
+}
+
+type QuotaUsage struct {
+	Name         string `tfschema:"name"`
+	DisplayName  string `tfschema:"display_name"`
+	ResourceType string `tfschema:"resource_type"`
+	Unit         string `tfschema:"unit"`
+	CurrentValue int64  `tfschema:"current_value"`
+	Limit        int64  `tfschema:"limit"`
+}
+
+var _ sdk.DataSource = QuotaUsagesDataSource{}
+
+func (d QuotaUsagesDataSource) ModelObject() interface{} {
+	return &QuotaUsagesDataSourceModel{}
+}
+
+func (d QuotaUsagesDataSource) ResourceType() string {
+	return "azurerm_quota_usages"
+}
+
+func (d QuotaUsagesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	resourceProvider := resourceProviderSchema()
+	resourceProvider.ForceNew = false
+
+	return map[string]*pluginsdk.Schema{
+		"resource_provider": resourceProvider,
+
+		"location": commonschema.LocationWithoutForceNew(),
+	}
+}
+
+func (d QuotaUsagesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"usages": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"current_value": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"limit": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d QuotaUsagesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			quotaClient := metadata.Client.Quota.V20230201.QuotaInformation
+			usagesClient := metadata.Client.Quota.V20230201.UsagesInformation
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config QuotaUsagesDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := newQuotaScope(subscriptionId, config.ResourceProvider, config.Location)
+
+			// the current usage and the limits are returned by separate APIs, so these are combined using the name of the Quota
+			quotas, err := quotaClient.QuotaListComplete(ctx, scope.ScopeId())
+			if err != nil {
+				return fmt.Errorf("listing Quotas for %q: %+v", scope.ID(), err)
+			}
+
+			limits := make(map[string]int64)
+			for _, item := range quotas.Items {
+				if props := item.Properties; props != nil && props.Name != nil && props.Name.Value != nil {
+					limits[strings.ToLower(*props.Name.Value)] = flattenQuotaLimit(props.Limit)
+				}
+			}
+
+			usages, err := usagesClient.UsagesListComplete(ctx, scope.ScopeId())
+			if err != nil {
+				return fmt.Errorf("listing Usages for %q: %+v", scope.ID(), err)
+			}
+
+			state := QuotaUsagesDataSourceModel{
+				ResourceProvider: scope.ResourceProvider,
+				Location:         scope.Location,
+				Usages:           make([]QuotaUsage, 0),
+			}
+
+			for _, item := range usages.Items {
+				props := item.Properties
+				if props == nil || props.Name == nil {
+					continue
+				}
+
+				usage := QuotaUsage{
+					Name:         pointer.From(props.Name.Value),
+					DisplayName:  pointer.From(props.Name.LocalizedValue),
+					ResourceType: pointer.From(props.ResourceType),
+					Unit:         pointer.From(props.Unit),
+					Limit:        limits[strings.ToLower(pointer.From(props.Name.Value))],
+				}
+				if v := props.Usages; v != nil {
+					usage.CurrentValue = v.Value
+				}
+
+				state.Usages = append(state.Usages, usage)
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Quota/usages", scope.ID()))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type QuotaUsagesDataSource struct{}
+
+func TestAccQuotaUsagesDataSource_compute(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_quota_usages", "test")
+	d := QuotaUsagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data, "Microsoft.Compute"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("usages.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("usages.0.name").Exists(),
+				check.That(data.ResourceName).Key("usages.0.limit").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccQuotaUsagesDataSource_network(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_quota_usages", "test")
+	d := QuotaUsagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data, "Microsoft.Network"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("usages.#").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (QuotaUsagesDataSource) basic(data acceptance.TestData, resourceProvider string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_quota_usages" "test" {
+  resource_provider = %q
+  location          = %q
+}
+`, resourceProvider, data.Locations.Primary)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/quota"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Quota",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Quota"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		QuotaUsagesDataSource{},
+	}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		QuotaResource{},
+	}
+}
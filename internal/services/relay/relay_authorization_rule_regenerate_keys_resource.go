@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RelayAuthorizationRuleRegenerateKeysModel struct {
+	AuthorizationRuleId string            `tfschema:"authorization_rule_id"`
+	KeyType             string            `tfschema:"key_type"`
+	Triggers            map[string]string `tfschema:"triggers"`
+	Key                 string            `tfschema:"key"`
+	ConnectionString    string            `tfschema:"connection_string"`
+}
+
+var _ sdk.Resource = RelayAuthorizationRuleRegenerateKeysResource{}
+
+type RelayAuthorizationRuleRegenerateKeysResource struct{}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) ResourceType() string {
+	return "azurerm_relay_authorization_rule_regenerate_keys"
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) ModelObject() interface{} {
+	return &RelayAuthorizationRuleRegenerateKeysModel{}
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validateRelayAuthorizationRuleID
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"authorization_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateRelayAuthorizationRuleID,
+		},
+
+		"key_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForKeyType(), false),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model RelayAuthorizationRuleRegenerateKeysModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(model.AuthorizationRuleId)
+			defer locks.UnlockByID(model.AuthorizationRuleId)
+
+			// the Authorization Rule can either belong to the Namespace or to a Hybrid Connection within it
+			if hybridConnectionRuleId, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(model.AuthorizationRuleId); err == nil {
+				client := metadata.Client.Relay.HybridConnectionsClient
+
+				metadata.Logger.Infof("regenerating the %s for %s", model.KeyType, hybridConnectionRuleId)
+				parameters := hybridconnections.RegenerateAccessKeyParameters{
+					KeyType: hybridconnections.KeyType(model.KeyType),
+				}
+				resp, err := client.RegenerateKeys(ctx, *hybridConnectionRuleId, parameters)
+				if err != nil {
+					return fmt.Errorf("regenerating the %s for %s: %+v", model.KeyType, hybridConnectionRuleId, err)
+				}
+				if resp.Model == nil {
+					return fmt.Errorf("regenerating the %s for %s: `model` was nil", model.KeyType, hybridConnectionRuleId)
+				}
+
+				model.Key, model.ConnectionString = relayRegeneratedKey(model.KeyType, resp.Model.PrimaryKey, resp.Model.PrimaryConnectionString, resp.Model.SecondaryKey, resp.Model.SecondaryConnectionString)
+
+				metadata.SetID(hybridConnectionRuleId)
+				return metadata.Encode(&model)
+			}
+
+			namespaceRuleId, err := namespaces.ParseAuthorizationRuleID(model.AuthorizationRuleId)
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.Relay.NamespacesClient
+
+			metadata.Logger.Infof("regenerating the %s for %s", model.KeyType, namespaceRuleId)
+			parameters := namespaces.RegenerateAccessKeyParameters{
+				KeyType: namespaces.KeyType(model.KeyType),
+			}
+			resp, err := client.RegenerateKeys(ctx, *namespaceRuleId, parameters)
+			if err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", model.KeyType, namespaceRuleId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("regenerating the %s for %s: `model` was nil", model.KeyType, namespaceRuleId)
+			}
+
+			model.Key, model.ConnectionString = relayRegeneratedKey(model.KeyType, resp.Model.PrimaryKey, resp.Model.PrimaryConnectionString, resp.Model.SecondaryKey, resp.Model.SecondaryConnectionString)
+
+			metadata.SetID(namespaceRuleId)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if hybridConnectionRuleId, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(metadata.ResourceData.Id()); err == nil {
+				resp, err := metadata.Client.Relay.HybridConnectionsClient.GetAuthorizationRule(ctx, *hybridConnectionRuleId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(hybridConnectionRuleId)
+					}
+					return fmt.Errorf("retrieving %s: %+v", hybridConnectionRuleId, err)
+				}
+			} else {
+				namespaceRuleId, err := namespaces.ParseAuthorizationRuleID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				resp, err := metadata.Client.Relay.NamespacesClient.GetAuthorizationRule(ctx, *namespaceRuleId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(namespaceRuleId)
+					}
+					return fmt.Errorf("retrieving %s: %+v", namespaceRuleId, err)
+				}
+			}
+
+			// the regenerated key is retained from when the keys were regenerated, since the keys of the
+			// Authorization Rule are exposed by the Authorization Rule resources
+			var state RelayAuthorizationRuleRegenerateKeysModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.AuthorizationRuleId = metadata.ResourceData.Id()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: removing this resource doesn't restore the previous keys of the Authorization Rule
+			return nil
+		},
+	}
+}
+
+func relayRegeneratedKey(keyType string, primaryKey, primaryConnectionString, secondaryKey, secondaryConnectionString *string) (string, string) {
+	if keyType == string(namespaces.KeyTypeSecondaryKey) {
+		return pointer.From(secondaryKey), pointer.From(secondaryConnectionString)
+	}
+	return pointer.From(primaryKey), pointer.From(primaryConnectionString)
+}
+
+// validateRelayAuthorizationRuleID validates that the value is the ID of either a Relay Namespace Authorization Rule
+// or a Relay Hybrid Connection Authorization Rule
+func validateRelayAuthorizationRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(v); err == nil {
+		return
+	}
+
+	if _, err := namespaces.ParseAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a Relay Namespace or Hybrid Connection Authorization Rule ID: %+v", key, err))
+	}
+
+	return
+}
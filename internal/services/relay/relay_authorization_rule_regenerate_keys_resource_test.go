@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RelayAuthorizationRuleRegenerateKeysResource struct{}
+
+func TestAccRelayAuthorizationRuleRegenerateKeys_namespace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_authorization_rule_regenerate_keys", "test")
+	r := RelayAuthorizationRuleRegenerateKeysResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.namespace(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+				check.That(data.ResourceName).Key("connection_string").Exists(),
+			),
+		},
+		{
+			Config: r.namespace(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccRelayAuthorizationRuleRegenerateKeys_hybridConnection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_authorization_rule_regenerate_keys", "test")
+	r := RelayAuthorizationRuleRegenerateKeysResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hybridConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+				check.That(data.ResourceName).Key("connection_string").Exists(),
+			),
+		},
+	})
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if id, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(state.ID); err == nil {
+		resp, err := clients.Relay.HybridConnectionsClient.GetAuthorizationRule(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		return utils.Bool(resp.Model != nil), nil
+	}
+
+	id, err := namespaces.ParseAuthorizationRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Relay.NamespacesClient.GetAuthorizationRule(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) namespace(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_authorization_rule_regenerate_keys" "test" {
+  authorization_rule_id = azurerm_relay_namespace_authorization_rule.test.id
+  key_type              = "SecondaryKey"
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+`, RelayNamespaceAuthorizationRuleResource{}.basic(data), trigger)
+}
+
+func (r RelayAuthorizationRuleRegenerateKeysResource) hybridConnection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_authorization_rule_regenerate_keys" "test" {
+  authorization_rule_id = azurerm_relay_hybrid_connection_authorization_rule.test.id
+  key_type              = "PrimaryKey"
+}
+`, RelayHybridConnectionAuthorizationRuleResource{}.basic(data))
+}
@@ -96,6 +96,15 @@ func resourceRelayHybridConnectionAuthorizationRuleCreateUpdate(d *pluginsdk.Res
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+		for _, keyType := range []hybridconnections.KeyType{hybridconnections.KeyTypePrimaryKey, hybridconnections.KeyTypeSecondaryKey} {
+			if _, err := client.RegenerateKeys(ctx, resourceId, hybridconnections.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, resourceId, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceRelayHybridConnectionAuthorizationRuleRead(d, meta)
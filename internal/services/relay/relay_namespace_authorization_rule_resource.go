@@ -90,6 +90,15 @@ func resourceRelayNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceDa
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+		for _, keyType := range []namespaces.KeyType{namespaces.KeyTypePrimaryKey, namespaces.KeyTypeSecondaryKey} {
+			if _, err := client.RegenerateKeys(ctx, resourceId, namespaces.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, resourceId, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceRelayNamespaceAuthorizationRuleRead(d, meta)
@@ -52,6 +52,32 @@ func TestAccRelayNamespaceAuthorizationRule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccRelayNamespaceAuthorizationRule_rotationTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace_authorization_rule", "test")
+	r := RelayNamespaceAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTriggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+		{
+			Config: r.rotationTriggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+	})
+}
+
 func (t RelayNamespaceAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := namespaces.ParseAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -115,3 +141,38 @@ resource "azurerm_relay_namespace_authorization_rule" "import" {
 }
 `, r.basic(data))
 }
+
+func (RelayNamespaceAuthorizationRuleResource) rotationTriggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_namespace_authorization_rule" "test" {
+  name                = "acctestrnak-%[1]d"
+  namespace_name      = azurerm_relay_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = false
+
+  rotation_triggers = {
+    rotation = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, trigger)
+}
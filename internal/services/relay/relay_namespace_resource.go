@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -60,11 +61,45 @@ func resourceRelayNamespace() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"metric_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"private_endpoint_connection": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_endpoint_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"primary_connection_string": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -116,6 +151,11 @@ func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+	}
+
 	skuTier := namespaces.SkuTier(d.Get("sku_name").(string))
 	parameters := namespaces.RelayNamespace{
 		Location: azure.NormalizeLocation(d.Get("location").(string)),
@@ -123,8 +163,10 @@ func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			Name: namespaces.SkuName(d.Get("sku_name").(string)),
 			Tier: &skuTier,
 		},
-		Properties: &namespaces.RelayNamespaceProperties{},
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &namespaces.RelayNamespaceProperties{
+			PublicNetworkAccess: &publicNetworkAccess,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
@@ -173,6 +215,8 @@ func resourceRelayNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) err
 
 		if props := model.Properties; props != nil {
 			d.Set("metric_id", props.MetricId)
+			d.Set("public_network_access_enabled", props.PublicNetworkAccess == nil || *props.PublicNetworkAccess != namespaces.PublicNetworkAccessDisabled)
+			d.Set("private_endpoint_connection", flattenRelayNamespacePrivateEndpointConnections(props.PrivateEndpointConnections))
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -235,3 +279,31 @@ func relayNamespaceDeleteRefreshFunc(ctx context.Context, client *namespaces.Nam
 		return res, "Pending", nil
 	}
 }
+
+func flattenRelayNamespacePrivateEndpointConnections(input *[]namespaces.PrivateEndpointConnection) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		privateEndpointId := ""
+		status := ""
+		if props := item.Properties; props != nil {
+			if props.PrivateEndpoint != nil {
+				privateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+			}
+			if state := props.PrivateLinkServiceConnectionState; state != nil && state.Status != nil {
+				status = string(*state.Status)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                  pointer.From(item.Id),
+			"name":                pointer.From(item.Name),
+			"private_endpoint_id": privateEndpointId,
+			"status":              status,
+		})
+	}
+	return output
+}
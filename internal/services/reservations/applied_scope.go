@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reservations
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/reservations/mgmt/2022-03-01/reservations" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AppliedScope struct {
+	ResourceGroupId string `tfschema:"resource_group_id"`
+	SubscriptionId  string `tfschema:"subscription_id"`
+}
+
+func appliedScopeTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(reservations.AppliedScopeTypeShared),
+			string(reservations.AppliedScopeTypeSingle),
+		}, false),
+	}
+}
+
+func appliedScopeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_group_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateResourceGroupID,
+				},
+
+				"subscription_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateSubscriptionID,
+				},
+			},
+		},
+	}
+}
+
+// validateAppliedScope checks that the `applied_scope` block contains the fields required by the `applied_scope_type`
+func validateAppliedScope(scopeType string, input []AppliedScope) error {
+	scope := AppliedScope{}
+	if len(input) > 0 {
+		scope = input[0]
+	}
+
+	switch reservations.AppliedScopeType(scopeType) {
+	case reservations.AppliedScopeTypeShared:
+		if len(input) > 0 {
+			return fmt.Errorf("`applied_scope` cannot be specified when `applied_scope_type` is `%s`", reservations.AppliedScopeTypeShared)
+		}
+
+	case reservations.AppliedScopeTypeSingle:
+		if (scope.SubscriptionId == "") == (scope.ResourceGroupId == "") {
+			return fmt.Errorf("exactly one of `applied_scope.0.subscription_id` or `applied_scope.0.resource_group_id` must be specified when `applied_scope_type` is `%s`", reservations.AppliedScopeTypeSingle)
+		}
+	}
+
+	return nil
+}
+
+func expandAppliedScopes(input []AppliedScope) *[]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	scope := input[0]
+	if scope.ResourceGroupId != "" {
+		return &[]string{scope.ResourceGroupId}
+	}
+	return &[]string{scope.SubscriptionId}
+}
+
+func flattenAppliedScopes(input *[]string) []AppliedScope {
+	output := make([]AppliedScope, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		scope := AppliedScope{}
+		if _, err := commonids.ParseResourceGroupIDInsensitively(v); err == nil {
+			scope.ResourceGroupId = v
+		} else {
+			scope.SubscriptionId = v
+		}
+		output = append(output, scope)
+	}
+
+	return output
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/reservations/mgmt/2022-03-01/reservations" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	OrderClient       *reservations.OrderClient
+	ReservationClient *reservations.Client
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	orderClient := reservations.NewOrderClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&orderClient.Client, o.ResourceManagerAuthorizer)
+
+	reservationClient := reservations.NewClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&reservationClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		OrderClient:       &orderClient,
+		ReservationClient: &reservationClient,
+	}
+}
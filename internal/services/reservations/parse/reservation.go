@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+var _ resourceids.Id = ReservationId{}
+
+type ReservationId struct {
+	ReservationOrderName string
+	Name                 string
+}
+
+func NewReservationID(reservationOrderName, name string) ReservationId {
+	return ReservationId{
+		ReservationOrderName: reservationOrderName,
+		Name:                 name,
+	}
+}
+
+func (id ReservationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Reservation Order Name %q", id.ReservationOrderName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Reservation", segmentsStr)
+}
+
+func (id ReservationId) ID() string {
+	fmtString := "/providers/Microsoft.Capacity/reservationOrders/%s/reservations/%s"
+	return fmt.Sprintf(fmtString, id.ReservationOrderName, id.Name)
+}
+
+// ReservationIDInsensitively parses a Reservation ID into an ReservationId struct, insensitively
+// since the API returns the segments of the Reservation ID in lower-case
+func ReservationIDInsensitively(input string) (*ReservationId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ReservationId{}
+
+	// find the correct casing for the 'reservationOrders' and 'reservations' segments
+	reservationOrdersKey := "reservationOrders"
+	reservationsKey := "reservations"
+	for key := range id.Path {
+		if strings.EqualFold(key, reservationOrdersKey) {
+			reservationOrdersKey = key
+		}
+		if strings.EqualFold(key, reservationsKey) {
+			reservationsKey = key
+		}
+	}
+
+	if resourceId.ReservationOrderName, err = id.PopSegment(reservationOrdersKey); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment(reservationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+var _ resourceids.Id = ReservationOrderId{}
+
+type ReservationOrderId struct {
+	Name string
+}
+
+func NewReservationOrderID(name string) ReservationOrderId {
+	return ReservationOrderId{
+		Name: name,
+	}
+}
+
+func (id ReservationOrderId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Reservation Order", segmentsStr)
+}
+
+func (id ReservationOrderId) ID() string {
+	fmtString := "/providers/Microsoft.Capacity/reservationOrders/%s"
+	return fmt.Sprintf(fmtString, id.Name)
+}
+
+// ReservationOrderID parses a ReservationOrder ID into an ReservationOrderId struct
+func ReservationOrderID(input string) (*ReservationOrderId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ReservationOrderId{}
+
+	if resourceId.Name, err = id.PopSegment("reservationOrders"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
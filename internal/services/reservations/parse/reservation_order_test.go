@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestReservationOrderIDFormatter(t *testing.T) {
+	actual := NewReservationOrderID("00000000-0000-0000-0000-000000000000").ID()
+	expected := "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestReservationOrderID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ReservationOrderId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Capacity/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Capacity/reservationOrders/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000",
+			Expected: &ReservationOrderId{
+				Name: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.CAPACITY/RESERVATIONORDERS/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ReservationOrderID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
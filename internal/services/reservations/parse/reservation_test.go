@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestReservationIDFormatter(t *testing.T) {
+	actual := NewReservationID("00000000-0000-0000-0000-000000000000", "11111111-1111-1111-1111-111111111111").ID()
+	expected := "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/reservations/11111111-1111-1111-1111-111111111111"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestReservationIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ReservationId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing ReservationOrderName
+			Input: "/providers/Microsoft.Capacity/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/reservations/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Capacity/reservationOrders/00000000-0000-0000-0000-000000000000/reservations/11111111-1111-1111-1111-111111111111",
+			Expected: &ReservationId{
+				ReservationOrderName: "00000000-0000-0000-0000-000000000000",
+				Name:                 "11111111-1111-1111-1111-111111111111",
+			},
+		},
+
+		{
+			// lower-cased, as returned by the API
+			Input: "/providers/microsoft.capacity/reservationorders/00000000-0000-0000-0000-000000000000/reservations/11111111-1111-1111-1111-111111111111",
+			Expected: &ReservationId{
+				ReservationOrderName: "00000000-0000-0000-0000-000000000000",
+				Name:                 "11111111-1111-1111-1111-111111111111",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ReservationIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ReservationOrderName != v.Expected.ReservationOrderName {
+			t.Fatalf("Expected %q but got %q for ReservationOrderName", v.Expected.ReservationOrderName, actual.ReservationOrderName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,548 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/reservations/mgmt/2022-03-01/reservations" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReservedInstanceOrderResource struct{}
+
+type ReservedInstanceOrderModel struct {
+	DisplayName                string         `tfschema:"display_name"`
+	BillingScopeId             string         `tfschema:"billing_scope_id"`
+	Location                   string         `tfschema:"location"`
+	ReservedResourceType       string         `tfschema:"reserved_resource_type"`
+	SkuName                    string         `tfschema:"sku_name"`
+	Term                       string         `tfschema:"term"`
+	BillingPlan                string         `tfschema:"billing_plan"`
+	Quantity                   int64          `tfschema:"quantity"`
+	AppliedScopeType           string         `tfschema:"applied_scope_type"`
+	AppliedScope               []AppliedScope `tfschema:"applied_scope"`
+	InstanceFlexibilityEnabled bool           `tfschema:"instance_flexibility_enabled"`
+	RenewEnabled               bool           `tfschema:"renew_enabled"`
+
+	ExpiryDate     string       `tfschema:"expiry_date"`
+	PriceQuote     []PriceQuote `tfschema:"price_quote"`
+	ReservationIds []string     `tfschema:"reservation_ids"`
+}
+
+type PriceQuote struct {
+	BillingCurrencyCode  string  `tfschema:"billing_currency_code"`
+	BillingCurrencyTotal float64 `tfschema:"billing_currency_total"`
+	PricingCurrencyCode  string  `tfschema:"pricing_currency_code"`
+	PricingCurrencyTotal float64 `tfschema:"pricing_currency_total"`
+	SkuTitle             string  `tfschema:"sku_title"`
+}
+
+var (
+	_ sdk.ResourceWithCustomizeDiff = ReservedInstanceOrderResource{}
+	_ sdk.ResourceWithUpdate        = ReservedInstanceOrderResource{}
+)
+
+func (r ReservedInstanceOrderResource) ModelObject() interface{} {
+	return &ReservedInstanceOrderModel{}
+}
+
+func (r ReservedInstanceOrderResource) ResourceType() string {
+	return "azurerm_reserved_instance_order"
+}
+
+func (r ReservedInstanceOrderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ReservationOrderID
+}
+
+func (r ReservedInstanceOrderResource) Arguments() map[string]*pluginsdk.Schema {
+	// a purchased Reservation Order can't be deleted, so rather than being marked as ForceNew the arguments which can't
+	// be updated are checked in the CustomizeDiff, to avoid silently purchasing a second Reservation Order
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"billing_scope_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"location": commonschema.LocationWithoutForceNew(),
+
+		"reserved_resource_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice(func() (res []string) {
+				for _, v := range reservations.PossibleReservedResourceTypeValues() {
+					res = append(res, string(v))
+				}
+				return
+			}(), false),
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"term": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(reservations.ReservationTermP1Y),
+				string(reservations.ReservationTermP3Y),
+				string(reservations.ReservationTermP5Y),
+			}, false),
+		},
+
+		"quantity": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, math.MaxInt32),
+		},
+
+		"applied_scope_type": appliedScopeTypeSchema(),
+
+		"applied_scope": appliedScopeSchema(),
+
+		"billing_plan": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(reservations.ReservationBillingPlanUpfront),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(reservations.ReservationBillingPlanMonthly),
+				string(reservations.ReservationBillingPlanUpfront),
+			}, false),
+		},
+
+		"instance_flexibility_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"renew_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiry_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"price_quote": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"billing_currency_code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"billing_currency_total": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"pricing_currency_code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"pricing_currency_total": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"sku_title": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"reservation_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ReservedInstanceOrderModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			diff := metadata.ResourceDiff
+			if diff.NewValueKnown("applied_scope_type") && diff.NewValueKnown("applied_scope") {
+				if err := validateAppliedScope(config.AppliedScopeType, config.AppliedScope); err != nil {
+					return err
+				}
+			}
+
+			if diff.Id() != "" {
+				for _, key := range reservedInstanceOrderImmutableArguments {
+					if diff.HasChange(key) {
+						return fmt.Errorf("`%s` can't be changed once the Reserved Instance Order has been purchased - the Reservation Order can only be exchanged or refunded through the Azure Portal, after which it can be removed from the state using `terraform state rm`", key)
+					}
+				}
+
+				// the price is only quoted when a new order will be purchased
+				return nil
+			}
+
+			// and once all the inputs are known
+			for _, key := range append(reservedInstanceOrderImmutableArguments, "applied_scope_type", "applied_scope", "instance_flexibility_enabled", "renew_enabled") {
+				if !diff.NewValueKnown(key) {
+					return nil
+				}
+			}
+
+			client := metadata.Client.Reservations.OrderClient
+			resp, err := client.Calculate(ctx, expandReservedInstanceOrderPurchaseRequest(config))
+			if err != nil {
+				return fmt.Errorf("calculating the price of Reserved Instance Order %q: %+v", config.DisplayName, err)
+			}
+
+			return diff.SetNew("price_quote", flattenReservedInstanceOrderPriceQuote(resp.Properties))
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.OrderClient
+
+			var config ReservedInstanceOrderModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := expandReservedInstanceOrderPurchaseRequest(config)
+
+			// the ID of the Reservation Order to purchase is allocated by the service when the price is calculated
+			quote, err := client.Calculate(ctx, payload)
+			if err != nil {
+				return fmt.Errorf("calculating the price of Reserved Instance Order %q: %+v", config.DisplayName, err)
+			}
+			if quote.Properties == nil || pointer.From(quote.Properties.ReservationOrderID) == "" {
+				return fmt.Errorf("calculating the price of Reserved Instance Order %q: `reservationOrderId` was nil", config.DisplayName)
+			}
+
+			id := parse.NewReservationOrderID(*quote.Properties.ReservationOrderID)
+
+			future, err := client.Purchase(ctx, id.Name, payload)
+			if err != nil {
+				return fmt.Errorf("purchasing %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the purchase of %s: %+v", id, err)
+			}
+
+			// the quote the order was purchased with is retained, since the service doesn't return it afterwards
+			if err := metadata.ResourceData.Set("price_quote", flattenReservedInstanceOrderPriceQuote(quote.Properties)); err != nil {
+				return fmt.Errorf("setting `price_quote`: %+v", err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.OrderClient
+
+			id, err := parse.ReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			reservationList, err := listReservedInstanceOrderReservations(ctx, metadata.Client.Reservations.ReservationClient, *id)
+			if err != nil {
+				return err
+			}
+
+			// the price quote and any fields not returned for the individual reservations are retained from the state
+			var state ReservedInstanceOrderModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if props := resp.OrderProperties; props != nil {
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.Term = string(props.Term)
+				state.BillingPlan = string(props.BillingPlan)
+
+				expiryDate := ""
+				if props.ExpiryDate != nil {
+					expiryDate = props.ExpiryDate.String()
+				}
+				state.ExpiryDate = expiryDate
+			}
+
+			reservationIds := make([]string, 0)
+			for i, reservation := range reservationList {
+				reservationIds = append(reservationIds, pointer.From(reservation.ID))
+
+				// all the reservations within an order are purchased with the same configuration
+				if i == 0 {
+					flattenReservedInstanceOrderReservation(reservation, &state)
+				}
+			}
+			state.ReservationIds = reservationIds
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationClient
+
+			id, err := parse.ReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ReservedInstanceOrderModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := reservations.PatchProperties{}
+
+			if metadata.ResourceData.HasChanges("applied_scope_type", "applied_scope") {
+				props.AppliedScopeType = reservations.AppliedScopeType(config.AppliedScopeType)
+				props.AppliedScopes = expandAppliedScopes(config.AppliedScope)
+			}
+
+			if metadata.ResourceData.HasChange("instance_flexibility_enabled") && reservations.ReservedResourceType(config.ReservedResourceType) == reservations.ReservedResourceTypeVirtualMachines {
+				props.InstanceFlexibility = expandReservedInstanceOrderInstanceFlexibility(config.InstanceFlexibilityEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("renew_enabled") {
+				props.Renew = pointer.To(config.RenewEnabled)
+			}
+
+			reservationList, err := listReservedInstanceOrderReservations(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			// the applied scope and renewal are configured on each of the reservations within the order
+			for _, reservation := range reservationList {
+				reservationId, err := parse.ReservationIDInsensitively(pointer.From(reservation.ID))
+				if err != nil {
+					return err
+				}
+
+				future, err := client.Update(ctx, reservationId.ReservationOrderName, reservationId.Name, reservations.Patch{
+					PatchProperties: &props,
+				})
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", *reservationId, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for the update of %s: %+v", *reservationId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ReservedInstanceOrderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a purchased Reservation Order can't be deleted, only exchanged or refunded through a support process
+			log.Printf("[WARN] %s can't be deleted and is only being removed from the state - it remains purchased until it's exchanged or refunded", *id)
+
+			return nil
+		},
+	}
+}
+
+// reservedInstanceOrderImmutableArguments are the arguments which are fixed when the Reservation Order is purchased
+var reservedInstanceOrderImmutableArguments = []string{
+	"display_name",
+	"billing_scope_id",
+	"location",
+	"reserved_resource_type",
+	"sku_name",
+	"term",
+	"quantity",
+	"billing_plan",
+}
+
+func listReservedInstanceOrderReservations(ctx context.Context, client *reservations.Client, id parse.ReservationOrderId) ([]reservations.Response, error) {
+	iterator, err := client.ListComplete(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("listing the reservations within %s: %+v", id, err)
+	}
+
+	results := make([]reservations.Response, 0)
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing the reservations within %s: %+v", id, err)
+		}
+	}
+
+	return results, nil
+}
+
+func expandReservedInstanceOrderPurchaseRequest(input ReservedInstanceOrderModel) reservations.PurchaseRequest {
+	reservedResourceType := reservations.ReservedResourceType(input.ReservedResourceType)
+
+	props := reservations.PurchaseRequestProperties{
+		AppliedScopeType:     reservations.AppliedScopeType(input.AppliedScopeType),
+		AppliedScopes:        expandAppliedScopes(input.AppliedScope),
+		BillingPlan:          reservations.ReservationBillingPlan(input.BillingPlan),
+		BillingScopeID:       pointer.To(input.BillingScopeId),
+		DisplayName:          pointer.To(input.DisplayName),
+		Quantity:             pointer.To(int32(input.Quantity)),
+		Renew:                pointer.To(input.RenewEnabled),
+		ReservedResourceType: reservedResourceType,
+		Term:                 reservations.ReservationTerm(input.Term),
+	}
+
+	// instance size flexibility is only supported by Virtual Machine reservations
+	if reservedResourceType == reservations.ReservedResourceTypeVirtualMachines {
+		props.ReservedResourceProperties = &reservations.PurchaseRequestPropertiesReservedResourceProperties{
+			InstanceFlexibility: expandReservedInstanceOrderInstanceFlexibility(input.InstanceFlexibilityEnabled),
+		}
+	}
+
+	return reservations.PurchaseRequest{
+		Location:                  pointer.To(location.Normalize(input.Location)),
+		PurchaseRequestProperties: &props,
+		Sku: &reservations.SkuName{
+			Name: pointer.To(input.SkuName),
+		},
+	}
+}
+
+func expandReservedInstanceOrderInstanceFlexibility(input bool) reservations.InstanceFlexibility {
+	if input {
+		return reservations.InstanceFlexibilityOn
+	}
+	return reservations.InstanceFlexibilityOff
+}
+
+func flattenReservedInstanceOrderReservation(input reservations.Response, state *ReservedInstanceOrderModel) {
+	if v := input.Location; v != nil {
+		state.Location = location.Normalize(*v)
+	}
+	if sku := input.Sku; sku != nil && sku.Name != nil {
+		state.SkuName = *sku.Name
+	}
+
+	props := input.Properties
+	if props == nil {
+		return
+	}
+
+	state.AppliedScopeType = string(props.AppliedScopeType)
+	state.ReservedResourceType = string(props.ReservedResourceType)
+	if v := props.BillingScopeID; v != nil {
+		state.BillingScopeId = *v
+	}
+	if v := props.Quantity; v != nil {
+		state.Quantity = int64(*v)
+	}
+	if v := props.Renew; v != nil {
+		state.RenewEnabled = *v
+	}
+	if props.InstanceFlexibility != "" {
+		state.InstanceFlexibilityEnabled = strings.EqualFold(string(props.InstanceFlexibility), string(reservations.InstanceFlexibilityOn))
+	}
+
+	appliedScope := make([]AppliedScope, 0)
+	if props.AppliedScopeType != reservations.AppliedScopeTypeShared {
+		appliedScope = flattenAppliedScopes(props.AppliedScopes)
+	}
+	state.AppliedScope = appliedScope
+}
+
+func flattenReservedInstanceOrderPriceQuote(input *reservations.CalculatePriceResponseProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	quote := map[string]interface{}{
+		"billing_currency_code":  "",
+		"billing_currency_total": 0.0,
+		"pricing_currency_code":  "",
+		"pricing_currency_total": 0.0,
+		"sku_title":              pointer.From(input.SkuTitle),
+	}
+	if v := input.BillingCurrencyTotal; v != nil {
+		quote["billing_currency_code"] = pointer.From(v.CurrencyCode)
+		quote["billing_currency_total"] = pointer.From(v.Amount)
+	}
+	if v := input.PricingCurrencyTotal; v != nil {
+		quote["pricing_currency_code"] = pointer.From(v.CurrencyCode)
+		quote["pricing_currency_total"] = pointer.From(v.Amount)
+	}
+
+	return []interface{}{quote}
+}
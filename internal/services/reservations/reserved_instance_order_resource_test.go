@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ReservedInstanceOrderResource struct{}
+
+func TestAccReservedInstanceOrder_basic(t *testing.T) {
+	// purchasing a reservation incurs a commitment that can't be deleted, so this has to be explicitly opted into
+	if os.Getenv("ARM_TEST_RESERVATION_PURCHASE") == "" {
+		t.Skip("skipping tests - ARM_TEST_RESERVATION_PURCHASE was not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reserved_instance_order", "test")
+	r := ReservedInstanceOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("price_quote.0.billing_currency_code").Exists(),
+				check.That(data.ResourceName).Key("reservation_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("price_quote"),
+	})
+}
+
+func TestAccReservedInstanceOrder_update(t *testing.T) {
+	// purchasing a reservation incurs a commitment that can't be deleted, so this has to be explicitly opted into
+	if os.Getenv("ARM_TEST_RESERVATION_PURCHASE") == "" {
+		t.Skip("skipping tests - ARM_TEST_RESERVATION_PURCHASE was not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reserved_instance_order", "test")
+	r := ReservedInstanceOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("price_quote"),
+		{
+			Config: r.sharedScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("applied_scope_type").HasValue("Shared"),
+				check.That(data.ResourceName).Key("renew_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("price_quote"),
+		{
+			Config:      r.changedQuantity(data),
+			ExpectError: regexp.MustCompile("`quantity` can't be changed once the Reserved Instance Order has been purchased"),
+		},
+	})
+}
+
+func (ReservedInstanceOrderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ReservationOrderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.OrderClient.Get(ctx, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.OrderProperties != nil), nil
+}
+
+func (ReservedInstanceOrderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_reserved_instance_order" "test" {
+  display_name           = "acctest-rio-%[1]d"
+  billing_scope_id       = data.azurerm_subscription.current.id
+  location               = "%[2]s"
+  reserved_resource_type = "VirtualMachines"
+  sku_name               = "Standard_B1ls"
+  term                   = "P1Y"
+  billing_plan           = "Monthly"
+  quantity               = 1
+  applied_scope_type     = "Single"
+
+  applied_scope {
+    subscription_id = data.azurerm_subscription.current.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ReservedInstanceOrderResource) sharedScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_reserved_instance_order" "test" {
+  display_name           = "acctest-rio-%[1]d"
+  billing_scope_id       = data.azurerm_subscription.current.id
+  location               = "%[2]s"
+  reserved_resource_type = "VirtualMachines"
+  sku_name               = "Standard_B1ls"
+  term                   = "P1Y"
+  billing_plan           = "Monthly"
+  quantity               = 1
+  applied_scope_type     = "Shared"
+  renew_enabled          = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ReservedInstanceOrderResource) changedQuantity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_reserved_instance_order" "test" {
+  display_name           = "acctest-rio-%[1]d"
+  billing_scope_id       = data.azurerm_subscription.current.id
+  location               = "%[2]s"
+  reserved_resource_type = "VirtualMachines"
+  sku_name               = "Standard_B1ls"
+  term                   = "P1Y"
+  billing_plan           = "Monthly"
+  quantity               = 2
+  applied_scope_type     = "Shared"
+  renew_enabled          = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
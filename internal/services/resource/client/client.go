@@ -12,9 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	resourcesMove "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -27,6 +29,8 @@ type Client struct {
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
 	ResourceProvidersClient             *providers.ProvidersClient
+	ResourcesMoveClient                 *resourcesMove.ResourcesClient
+	TemplateSpecsClient                 *templatespecs.TemplateSpecsClient
 	TemplateSpecsVersionsClient         *templatespecversions.TemplateSpecVersionsClient
 	TagsClient                          *tags.TagsClient
 
@@ -83,6 +87,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceProvidersClient.Client, o.Authorizers.ResourceManager)
 
+	resourcesMoveClient, err := resourcesMove.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	o.Configure(resourcesMoveClient.Client, o.Authorizers.ResourceManager)
+
+	templateSpecsClient, err := templatespecs.NewTemplateSpecsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building TemplateSpecs client: %+v", err)
+	}
+	o.Configure(templateSpecsClient.Client, o.Authorizers.ResourceManager)
+
 	templateSpecsVersionsClient, err := templatespecversions.NewTemplateSpecVersionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building TemplateSpecVersions client: %+v", err)
@@ -113,6 +129,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
 		ResourceGroupsClient:                resourceGroupsClient,
 		ResourceProvidersClient:             resourceProvidersClient,
+		ResourcesMoveClient:                 resourcesMoveClient,
+		TemplateSpecsClient:                 templateSpecsClient,
 		TemplateSpecsVersionsClient:         templateSpecsVersionsClient,
 		TagsClient:                          tagsClient,
 
@@ -122,3 +140,21 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		options:         o,
 	}, nil
 }
+
+// GroupsClientForSubscription returns a Groups Client for the specified Subscription, for Resource Groups which
+// are managed in a different Subscription to the one the Provider is configured for
+func (c Client) GroupsClientForSubscription(subscriptionID string) *resources.GroupsClient {
+	// TODO: this method can be removed once this is moved to using `hashicorp/go-azure-sdk`
+	groupsClient := resources.NewGroupsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&groupsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &groupsClient
+}
+
+// ResourcesClientForSubscription returns a Resources Client for the specified Subscription, for Resource Groups
+// which are managed in a different Subscription to the one the Provider is configured for
+func (c Client) ResourcesClientForSubscription(subscriptionID string) *resources.Client {
+	// TODO: this method can be removed once this is moved to using `hashicorp/go-azure-sdk`
+	resourcesClient := resources.NewClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&resourcesClient.Client, c.options.ResourceManagerAuthorizer)
+	return &resourcesClient
+}
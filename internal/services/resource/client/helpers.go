@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	resourcesMove "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+// MoveResourceToResourceGroup moves the single resource `resourceId` into the Resource Group `targetResourceGroupName`
+// within the same Subscription.
+//
+// Azure requires that resources which depend on one another (for example a Virtual Machine and its Network Interfaces)
+// are moved within a single request - however these are managed as separate resources within Terraform, each of which
+// tracks its own ID, so moving them alongside this resource would leave them orphaned in the state. As such when any of
+// `dependentResourceIds` isn't already within the target Resource Group an error is returned listing them instead.
+func (c *Client) MoveResourceToResourceGroup(ctx context.Context, resourceId string, dependentResourceIds []string, targetResourceGroupName string) error {
+	source, err := azure.ParseAzureResourceID(resourceId)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", resourceId, err)
+	}
+
+	blocking := make([]string, 0)
+	for _, dependentId := range dependentResourceIds {
+		parsed, err := azure.ParseAzureResourceID(dependentId)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %+v", dependentId, err)
+		}
+		if strings.EqualFold(parsed.SubscriptionID, source.SubscriptionID) && strings.EqualFold(parsed.ResourceGroup, targetResourceGroupName) {
+			continue
+		}
+		blocking = append(blocking, fmt.Sprintf("  - %s", dependentId))
+	}
+
+	if len(blocking) > 0 {
+		return fmt.Errorf(`%q can't be moved to the Resource Group %q on its own, since Azure requires the following dependent resources to be moved in the same request:
+
+%s
+
+Terraform manages each of these as a separate resource and so can't move them together. To move them, either:
+
+  1. move all of these resources together outside of Terraform (for example using "az resource move"), then remove
+     them from the Terraform State and import them using their new Resource IDs, or
+  2. disassociate the dependent resources first, then move each resource by updating its "resource_group_name"`, resourceId, targetResourceGroupName, strings.Join(blocking, "\n"))
+	}
+
+	sourceResourceGroupId := commonids.NewResourceGroupID(source.SubscriptionID, source.ResourceGroup)
+	targetResourceGroupId := commonids.NewResourceGroupID(source.SubscriptionID, targetResourceGroupName)
+	input := resourcesMove.ResourcesMoveInfo{
+		Resources:           pointer.To([]string{resourceId}),
+		TargetResourceGroup: pointer.To(targetResourceGroupId.ID()),
+	}
+
+	if err := c.ResourcesMoveClient.MoveResourcesThenPoll(ctx, sourceResourceGroupId, input); err != nil {
+		return fmt.Errorf("moving %s from %s to %s: %+v", resourceId, sourceResourceGroupId, targetResourceGroupId, err)
+	}
+
+	return nil
+}
+
+// ResourceMovedOutsideOfTerraformError returns the error used when the resource `resourceId` being moved to
+// `targetResourceId` no longer exists at its original location - rather than assuming that a resource with the same
+// name in the target Resource Group is the same resource, the user is asked to import it.
+func ResourceMovedOutsideOfTerraformError(resourceId string, targetResourceId string) error {
+	return fmt.Errorf("%q was not found - if it has been moved to %q outside of Terraform, remove it from the Terraform State and import it using the new Resource ID", resourceId, targetResourceId)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = PrivateEndpointConnectionId{}
+
+// PrivateEndpointConnectionId is the ID of a Private Endpoint Connection to any Azure Resource which supports
+// Private Endpoints, since these share the same shape across Resource Providers, e.g.
+// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.KeyVault/vaults/{vaultName}/privateEndpointConnections/{connectionName}
+type PrivateEndpointConnectionId struct {
+	SubscriptionId string
+
+	// ParentId is the ID of the Resource the Private Endpoint is connected to
+	ParentId string
+
+	// ResourceProvider is the namespace of the Resource Provider, e.g. `Microsoft.KeyVault`
+	ResourceProvider string
+
+	// ParentResourceType is the type of the Resource the Private Endpoint is connected to, e.g. `vaults`
+	ParentResourceType string
+
+	ConnectionName string
+}
+
+func (id PrivateEndpointConnectionId) ID() string {
+	return fmt.Sprintf("%s/privateEndpointConnections/%s", id.ParentId, id.ConnectionName)
+}
+
+func (id PrivateEndpointConnectionId) String() string {
+	return fmt.Sprintf("Private Endpoint Connection %q (Resource %q)", id.ConnectionName, id.ParentId)
+}
+
+// ResourceType returns the type of the Private Endpoint Connection, e.g. `vaults/privateEndpointConnections`
+func (id PrivateEndpointConnectionId) ResourceType() string {
+	return fmt.Sprintf("%s/privateEndpointConnections", id.ParentResourceType)
+}
+
+// PrivateEndpointConnectionID parses the ID of a Private Endpoint Connection to any Azure Resource into a
+// PrivateEndpointConnectionId struct
+func PrivateEndpointConnectionID(input string) (*PrivateEndpointConnectionId, error) {
+	if !strings.HasPrefix(strings.ToLower(input), "/subscriptions/") {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	providersIndex := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if providersIndex == -1 {
+		return nil, fmt.Errorf("ID was missing the 'providers' element")
+	}
+
+	subscriptionId := strings.Split(input, "/")[2]
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the value for the 'subscriptions' element")
+	}
+
+	segments := strings.Split(input[providersIndex+len("/providers/"):], "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("ID contained an empty segment")
+		}
+	}
+
+	// the segments are the Resource Provider followed by pairs of resource types and names, where the last
+	// pair must be the Private Endpoint Connection, e.g. `Microsoft.KeyVault/vaults/{name}/privateEndpointConnections/{name}`
+	if len(segments) < 5 || len(segments)%2 == 0 {
+		return nil, fmt.Errorf("ID was not the ID of a Private Endpoint Connection to a Resource")
+	}
+
+	if !strings.EqualFold(segments[len(segments)-2], "privateEndpointConnections") {
+		return nil, fmt.Errorf("ID was missing the 'privateEndpointConnections' element")
+	}
+
+	resourceTypes := make([]string, 0)
+	for i := 1; i < len(segments)-2; i += 2 {
+		resourceTypes = append(resourceTypes, segments[i])
+	}
+
+	connectionName := segments[len(segments)-1]
+
+	return &PrivateEndpointConnectionId{
+		SubscriptionId:     subscriptionId,
+		ParentId:           strings.TrimSuffix(input, "/"+segments[len(segments)-2]+"/"+connectionName),
+		ResourceProvider:   segments[0],
+		ParentResourceType: strings.Join(resourceTypes, "/"),
+		ConnectionName:     connectionName,
+	}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpointConnectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateEndpointConnectionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions//resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+
+		{
+			// missing Providers
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Error: true,
+		},
+
+		{
+			// missing parent resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/privateEndpointConnections/connection1",
+			Error: true,
+		},
+
+		{
+			// not a private endpoint connection
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1",
+			Error: true,
+		},
+
+		{
+			// missing value for privateEndpointConnections
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/privateEndpointConnections/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/privateEndpointConnections/connection1",
+			Expected: &PrivateEndpointConnectionId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ParentId:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+				ResourceProvider:   "Microsoft.KeyVault",
+				ParentResourceType: "vaults",
+				ConnectionName:     "connection1",
+			},
+		},
+
+		{
+			// valid nested resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DocumentDB/mongoClusters/cluster1/databases/db1/privateEndpointConnections/connection1",
+			Expected: &PrivateEndpointConnectionId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ParentId:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DocumentDB/mongoClusters/cluster1/databases/db1",
+				ResourceProvider:   "Microsoft.DocumentDB",
+				ParentResourceType: "mongoClusters/databases",
+				ConnectionName:     "connection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PrivateEndpointConnectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ParentId != v.Expected.ParentId {
+			t.Fatalf("Expected %q but got %q for ParentId", v.Expected.ParentId, actual.ParentId)
+		}
+		if actual.ResourceProvider != v.Expected.ResourceProvider {
+			t.Fatalf("Expected %q but got %q for ResourceProvider", v.Expected.ResourceProvider, actual.ResourceProvider)
+		}
+		if actual.ParentResourceType != v.Expected.ParentResourceType {
+			t.Fatalf("Expected %q but got %q for ParentResourceType", v.Expected.ParentResourceType, actual.ParentResourceType)
+		}
+		if actual.ConnectionName != v.Expected.ConnectionName {
+			t.Fatalf("Expected %q but got %q for ConnectionName", v.Expected.ConnectionName, actual.ConnectionName)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	privateEndpointConnectionStatusApproved = "Approved"
+	privateEndpointConnectionStatusRejected = "Rejected"
+)
+
+type PrivateEndpointConnectionApprovalModel struct {
+	ConnectionId      string `tfschema:"connection_id"`
+	Status            string `tfschema:"status"`
+	Description       string `tfschema:"description"`
+	PrivateEndpointId string `tfschema:"private_endpoint_id"`
+}
+
+var _ sdk.ResourceWithUpdate = PrivateEndpointConnectionApprovalResource{}
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+func (r PrivateEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_private_endpoint_connection_approval"
+}
+
+func (r PrivateEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &PrivateEndpointConnectionApprovalModel{}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateEndpointConnectionID
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateEndpointConnectionID,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  privateEndpointConnectionStatusApproved,
+			ValidateFunc: validation.StringInSlice([]string{
+				privateEndpointConnectionStatusApproved,
+				privateEndpointConnectionStatusRejected,
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 140),
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.PrivateEndpointConnectionID(model.ConnectionId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ParentId)
+			defer locks.UnlockByID(id.ParentId)
+
+			if err := updatePrivateEndpointConnectionState(ctx, metadata, *id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourcesClient
+
+			id, err := parse.PrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			apiVersion, err := determinePrivateEndpointConnectionApiVersion(ctx, metadata.Client.Resource.ResourceProvidersClient, *id)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByID(ctx, id.ID(), apiVersion)
+			if err != nil {
+				if resp.StatusCode == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := PrivateEndpointConnectionApprovalModel{
+				ConnectionId: id.ID(),
+			}
+
+			if props, ok := resp.Properties.(map[string]interface{}); ok {
+				if privateEndpoint, ok := props["privateEndpoint"].(map[string]interface{}); ok {
+					state.PrivateEndpointId, _ = privateEndpoint["id"].(string)
+				}
+
+				if connectionState, ok := props["privateLinkServiceConnectionState"].(map[string]interface{}); ok {
+					state.Status, _ = connectionState["status"].(string)
+					state.Description, _ = connectionState["description"].(string)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ParentId)
+			defer locks.UnlockByID(id.ParentId)
+
+			return updatePrivateEndpointConnectionState(ctx, metadata, *id, model)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the connection belongs to the Private Endpoint, so it's left in the state it was approved (or rejected) in
+			// rather than being removed from the Resource
+			return nil
+		},
+	}
+}
+
+func updatePrivateEndpointConnectionState(ctx context.Context, metadata sdk.ResourceMetaData, id parse.PrivateEndpointConnectionId, model PrivateEndpointConnectionApprovalModel) error {
+	client := metadata.Client.Resource.ResourcesClient
+
+	apiVersion, err := determinePrivateEndpointConnectionApiVersion(ctx, metadata.Client.Resource.ResourceProvidersClient, id)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetByID(ctx, id.ID(), apiVersion)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	props, ok := existing.Properties.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	// the other fields of the connection state (such as `actionsRequired`) are retained, since these are
+	// required by some Resource Providers
+	connectionState, ok := props["privateLinkServiceConnectionState"].(map[string]interface{})
+	if !ok {
+		connectionState = make(map[string]interface{})
+	}
+	connectionState["status"] = model.Status
+	connectionState["description"] = model.Description
+	props["privateLinkServiceConnectionState"] = connectionState
+
+	metadata.Logger.Infof("updating the status of %s to %q", id, model.Status)
+	future, err := client.CreateOrUpdateByID(ctx, id.ID(), apiVersion, resources.GenericResource{
+		Properties: props,
+	})
+	if err != nil {
+		return fmt.Errorf("updating the status of %s to %q: %+v", id, model.Status, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the status of %s to be updated to %q: %+v", id, model.Status, err)
+	}
+
+	return nil
+}
+
+// determinePrivateEndpointConnectionApiVersion determines the API version which should be used for the Private
+// Endpoint Connection, since this differs for each Resource Provider
+func determinePrivateEndpointConnectionApiVersion(ctx context.Context, client *providers.ProvidersClient, id parse.PrivateEndpointConnectionId) (string, error) {
+	providerId := providers.NewSubscriptionProviderID(id.SubscriptionId, id.ResourceProvider)
+	resp, err := client.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+	if err != nil {
+		return "", fmt.Errorf("retrieving MetaData for %s: %+v", providerId, err)
+	}
+
+	resourceTypes := make([]providers.ProviderResourceType, 0)
+	if model := resp.Model; model != nil && model.ResourceTypes != nil {
+		resourceTypes = *model.ResourceTypes
+	}
+
+	// not all Resource Providers expose the Private Endpoint Connections as a Resource Type, in which case these
+	// are available in the same API versions as the Resource they belong to
+	for _, resourceType := range []string{id.ResourceType(), id.ParentResourceType} {
+		for _, item := range resourceTypes {
+			if item.ResourceType == nil || item.ApiVersions == nil || !strings.EqualFold(*item.ResourceType, resourceType) {
+				continue
+			}
+
+			// the API versions are ordered from newest to oldest, where stable API versions are preferred
+			apiVersions := *item.ApiVersions
+			for _, apiVersion := range apiVersions {
+				if !strings.Contains(strings.ToLower(apiVersion), "preview") {
+					return apiVersion, nil
+				}
+			}
+			if len(apiVersions) > 0 {
+				return apiVersions[0], nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unable to determine the API version for Resource Type %q (%s)", id.ResourceType(), providerId)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatelinkservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+func TestAccPrivateEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Approved"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("private_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Rejected"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	// the Private Endpoint Connection used in the tests is to a Private Link Service
+	id, err := privatelinkservices.ParsePrivateEndpointConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PrivateLinkServices.GetPrivateEndpointConnection(ctx, *id, privatelinkservices.DefaultGetPrivateEndpointConnectionOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.PrivateLinkServiceConnectionState != nil && model.Properties.PrivateLinkServiceConnectionState.Status != nil {
+		return utils.Bool(*model.Properties.PrivateLinkServiceConnectionState.Status != "Pending"), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PrivateEndpointConnectionApprovalResource) basic(data acceptance.TestData, status string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pecapproval-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "service" {
+  name                 = "acctestsnetservice-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.1.0/24"]
+
+  enforce_private_link_service_network_policies = true
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  sku                 = "Standard"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  sku                 = "Standard"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = azurerm_public_ip.test.name
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+}
+
+resource "azurerm_private_link_service" "test" {
+  name                = "acctestPLS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  nat_ip_configuration {
+    name      = "primaryIpConfiguration-%[1]d"
+    primary   = true
+    subnet_id = azurerm_subnet.service.id
+  }
+
+  load_balancer_frontend_ip_configuration_ids = [
+    azurerm_lb.test.frontend_ip_configuration.0.id
+  ]
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = azurerm_private_link_service.test.name
+    is_manual_connection           = true
+    private_connection_resource_id = azurerm_private_link_service.test.id
+    request_message                = "please approve"
+  }
+}
+
+data "azurerm_private_link_service_endpoint_connections" "test" {
+  service_id          = azurerm_private_endpoint.test.private_service_connection.0.private_connection_resource_id
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  connection_id = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_id
+  status        = %[3]q
+  description   = "%[3]s by Terraform"
+}
+`, data.RandomInteger, data.Locations.Primary, status)
+}
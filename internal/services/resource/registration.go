@@ -35,6 +35,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_resources":                            dataSourceResources(),
 		"azurerm_resource_group":                       dataSourceResourceGroup(),
+		"azurerm_resource_import_address":              dataSourceResourceImportAddress(),
 		"azurerm_template_spec_version":                dataSourceTemplateSpecVersion(),
 		"azurerm_management_group_template_deployment": dataSourceManagementGroupTemplateDeployment(),
 		"azurerm_resource_group_template_deployment":   dataSourceResourceGroupTemplateDeployment(),
@@ -69,10 +70,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		PrivateEndpointConnectionApprovalResource{},
 		ResourceManagementPrivateLinkAssociationResource{},
 		ResourceProviderRegistrationResource{},
+		ResourceProviderRegistrationSetResource{},
 		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
+		TemplateSpecResource{},
+		TemplateSpecVersionResource{},
 	}
 }
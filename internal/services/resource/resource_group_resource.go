@@ -54,12 +54,25 @@ func resourceResourceGroup() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
 		},
 	}
 }
 
 func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	// the Resource Group can be managed in a different Subscription to the one the Provider is configured for
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	if v, ok := d.GetOk("subscription_id"); ok {
+		subscriptionId = v.(string)
+	}
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(subscriptionId)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,7 +165,6 @@ func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface
 }
 
 func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,6 +173,8 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	resp, err := client.Get(ctx, id.ResourceGroup)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -175,11 +189,11 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("managed_by", pointer.From(resp.ManagedBy))
+	d.Set("subscription_id", id.SubscriptionId)
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,9 +202,11 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	// conditionally check for nested resources and error if they exist
 	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
-		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
+		resourceClient := meta.(*clients.Client).Resource.ResourcesClientForSubscription(id.SubscriptionId)
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
 			results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "provisioningState", utils.Int32(500))
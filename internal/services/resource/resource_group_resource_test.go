@@ -95,6 +95,27 @@ func TestAccResourceGroup_withManagedBy(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_withSubscriptionId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping as ARM_SUBSCRIPTION_ID_ALT is not specified")
+	}
+
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.withSubscriptionIdConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("subscription_id").HasValue(data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	r := ResourceGroupResource{}
@@ -288,3 +309,17 @@ resource "azurerm_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (t ResourceGroupResource) withSubscriptionIdConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name            = "acctestRG-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionIDAlt)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// genericResourceId is a Resource ID for a Resource Provider which doesn't exist, used to detect Importers which
+// accept any Resource ID (and as such can't be used to determine the Resource Type of a resource)
+const genericResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Example.ResourceProvider/exampleResources/example"
+
+var (
+	invalidResourceNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+	validResourceNamePrefix       = regexp.MustCompile(`^[a-zA-Z_]`)
+)
+
+func dataSourceResourceImportAddress() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceImportAddressRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"api_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-[a-zA-Z]+)?$`), "`api_version` must be in the format `YYYY-MM-DD` or `YYYY-MM-DD-preview`"),
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`), "`name` must be a valid Terraform resource name"),
+			},
+
+			"resource_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"address": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"import_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"candidate_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceImportAddressRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ResourcesClient
+	resourceTypes := meta.(*clients.Client).ResourceTypes
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Get("resource_id").(string)
+
+	// when the API Version is known the resource is retrieved, which both confirms that it exists and returns the
+	// canonical casing of the Resource ID - which is what the Importers validate against
+	importId := resourceId
+	if apiVersion := d.Get("api_version").(string); apiVersion != "" {
+		resp, err := client.GetByID(ctx, resourceId, apiVersion)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("the resource %q was not found using the API Version %q", resourceId, apiVersion)
+			}
+			return fmt.Errorf("retrieving %q using the API Version %q: %+v", resourceId, apiVersion, err)
+		}
+		if resp.ID != nil && strings.EqualFold(*resp.ID, resourceId) {
+			importId = *resp.ID
+		}
+	}
+
+	candidates := make([]string, 0)
+	for resourceType, resource := range resourceTypes {
+		if resource.DeprecationMessage != "" || resource.Importer == nil {
+			continue
+		}
+		if pluginsdk.ImporterValidatesResourceId(ctx, resource.Importer, genericResourceId) {
+			continue
+		}
+		if pluginsdk.ImporterValidatesResourceId(ctx, resource.Importer, importId) {
+			candidates = append(candidates, resourceType)
+		}
+	}
+	sort.Strings(candidates)
+
+	d.SetId(resourceId)
+	d.Set("import_id", importId)
+	d.Set("candidate_resource_types", candidates)
+
+	// when multiple Resource Types can import the Resource ID (e.g. `azurerm_linux_virtual_machine` and
+	// `azurerm_windows_virtual_machine`) the appropriate one has to be chosen from `candidate_resource_types`
+	resourceType := ""
+	address := ""
+	if len(candidates) == 1 {
+		resourceType = candidates[0]
+		address = fmt.Sprintf("%s.%s", resourceType, resourceImportAddressName(d.Get("name").(string), importId))
+	}
+	d.Set("resource_type", resourceType)
+	d.Set("address", address)
+
+	return nil
+}
+
+// resourceImportAddressName returns the name to use for the resource within its address - which when not specified
+// is derived from the last segment of the Resource ID
+func resourceImportAddressName(name string, resourceId string) string {
+	if name != "" {
+		return name
+	}
+
+	segments := strings.Split(strings.TrimSuffix(resourceId, "/"), "/")
+	name = invalidResourceNameCharacters.ReplaceAllString(segments[len(segments)-1], "_")
+	if !validResourceNamePrefix.MatchString(name) {
+		name = "_" + name
+	}
+	return name
+}
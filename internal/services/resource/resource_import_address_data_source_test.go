@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceImportAddressDataSource struct{}
+
+func TestAccDataSourceResourceImportAddress_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_import_address", "test")
+	r := ResourceImportAddressDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_type").HasValue("azurerm_public_ip"),
+				check.That(data.ResourceName).Key("address").HasValue(fmt.Sprintf("azurerm_public_ip.acctestpublicip-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("candidate_resource_types.#").HasValue("1"),
+				check.That(data.ResourceName).Key("import_id").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceImportAddress_multipleCandidates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_import_address", "test")
+	r := ResourceImportAddressDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.multipleCandidates(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_type").HasValue(""),
+				check.That(data.ResourceName).Key("address").HasValue(""),
+				check.That(data.ResourceName).Key("candidate_resource_types.#").HasValue("2"),
+				check.That(data.ResourceName).Key("candidate_resource_types.0").HasValue("azurerm_linux_virtual_machine"),
+				check.That(data.ResourceName).Key("candidate_resource_types.1").HasValue("azurerm_windows_virtual_machine"),
+			),
+		},
+	})
+}
+
+func (ResourceImportAddressDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+data "azurerm_resource_import_address" "test" {
+  resource_id = azurerm_public_ip.test.id
+  api_version = "2023-09-01"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ResourceImportAddressDataSource) multipleCandidates(data acceptance.TestData) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_import_address" "test" {
+  resource_id = "/subscriptions/${data.azurerm_client_config.current.subscription_id}/resourceGroups/example-resources/providers/Microsoft.Compute/virtualMachines/example"
+}
+`
+}
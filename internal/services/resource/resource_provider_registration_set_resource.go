@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ResourceProviderRegistrationSetResource{}
+
+type ResourceProviderRegistrationSetResource struct{}
+
+type ResourceProviderRegistrationSetModel struct {
+	ResourceProviders   []string `tfschema:"resource_providers"`
+	UnregisterUnmanaged bool     `tfschema:"unregister_unmanaged"`
+}
+
+func (r ResourceProviderRegistrationSetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_providers": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: resourceproviders.EnhancedValidate,
+			},
+		},
+
+		"unregister_unmanaged": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ResourceProviderRegistrationSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ResourceProviderRegistrationSetResource) ModelObject() interface{} {
+	return &ResourceProviderRegistrationSetModel{}
+}
+
+func (r ResourceProviderRegistrationSetResource) ResourceType() string {
+	return "azurerm_resource_provider_registration_set"
+}
+
+func (r ResourceProviderRegistrationSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateSubscriptionID
+}
+
+func (r ResourceProviderRegistrationSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ResourceProviderRegistrationSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			if err := r.reconcile(ctx, metadata, id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ResourceProviderRegistrationSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceProviderRegistrationSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registered, err := r.listRegistered(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			// Resource Providers which aren't registered are removed from the state, so that these are registered again
+			// on the next apply
+			state := ResourceProviderRegistrationSetModel{
+				ResourceProviders:   make([]string, 0),
+				UnregisterUnmanaged: model.UnregisterUnmanaged,
+			}
+			managed := make(map[string]struct{})
+			for _, name := range model.ResourceProviders {
+				managed[strings.ToLower(name)] = struct{}{}
+				if _, ok := registered[strings.ToLower(name)]; ok {
+					state.ResourceProviders = append(state.ResourceProviders, name)
+				}
+			}
+
+			// when imported, the set contains all of the Resource Providers which are registered
+			if len(model.ResourceProviders) == 0 {
+				for _, name := range registered {
+					if !r.isAutomaticallyRegistered(metadata, name) {
+						state.ResourceProviders = append(state.ResourceProviders, name)
+					}
+				}
+			}
+
+			// when these are being unregistered, any other Resource Providers which are registered are surfaced in the
+			// state so that the diff shows which Resource Providers will be unregistered
+			if model.UnregisterUnmanaged {
+				for key, name := range registered {
+					if _, ok := managed[key]; ok {
+						continue
+					}
+					if r.isAutomaticallyRegistered(metadata, name) {
+						continue
+					}
+					state.ResourceProviders = append(state.ResourceProviders, name)
+				}
+			}
+			sort.Strings(state.ResourceProviders)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ResourceProviderRegistrationSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceProviderRegistrationSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return r.reconcile(ctx, metadata, *id, model)
+		},
+	}
+}
+
+func (r ResourceProviderRegistrationSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// unregistering every Resource Provider in the set would break any Resources using them, so these are
+			// intentionally left registered and this resource is only removed from the state
+			log.Printf("[DEBUG] %s has been removed from the state, the Resource Providers remain registered", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+// reconcile registers the Resource Providers in the set which aren't registered, and (when enabled) unregisters any
+// other Resource Providers which are registered
+func (r ResourceProviderRegistrationSetResource) reconcile(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.SubscriptionId, model ResourceProviderRegistrationSetModel) error {
+	client := metadata.Client.Resource.ResourceProvidersClient
+
+	for _, name := range model.ResourceProviders {
+		if err := (ResourceProviderRegistrationResource{}).checkIfManagedByTerraform(name, metadata.Client.Account); err != nil {
+			return err
+		}
+	}
+
+	registered, err := r.listRegistered(ctx, metadata, id)
+	if err != nil {
+		return err
+	}
+
+	managed := make(map[string]struct{})
+	toRegister := make([]providers.SubscriptionProviderId, 0)
+	for _, name := range model.ResourceProviders {
+		managed[strings.ToLower(name)] = struct{}{}
+		if _, ok := registered[strings.ToLower(name)]; !ok {
+			toRegister = append(toRegister, providers.NewSubscriptionProviderID(id.SubscriptionId, name))
+		}
+	}
+
+	toUnregister := make([]providers.SubscriptionProviderId, 0)
+	if model.UnregisterUnmanaged {
+		for key, name := range registered {
+			if _, ok := managed[key]; ok {
+				continue
+			}
+			if r.isAutomaticallyRegistered(metadata, name) {
+				continue
+			}
+			toUnregister = append(toUnregister, providers.NewSubscriptionProviderID(id.SubscriptionId, name))
+		}
+	}
+
+	// the registrations are requested up-front and then polled, since registering each in turn takes a while
+	for _, providerId := range toRegister {
+		log.Printf("[DEBUG] Registering %s..", providerId)
+		if _, err := client.Register(ctx, providerId, providers.ProviderRegistrationRequest{}); err != nil {
+			return fmt.Errorf("registering %s: %+v", providerId, err)
+		}
+	}
+	for _, providerId := range toUnregister {
+		log.Printf("[DEBUG] Unregistering %s..", providerId)
+		if _, err := client.Unregister(ctx, providerId); err != nil {
+			return fmt.Errorf("unregistering %s: %+v", providerId, err)
+		}
+	}
+
+	for _, providerId := range toRegister {
+		log.Printf("[DEBUG] Waiting for %s to finish registering..", providerId)
+		pollerType := custompollers.NewResourceProviderRegistrationPoller(client, providerId)
+		poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to be registered: %+v", providerId, err)
+		}
+	}
+	for _, providerId := range toUnregister {
+		log.Printf("[DEBUG] Waiting for %s to finish unregistering..", providerId)
+		pollerType := custompollers.NewResourceProviderUnregistrationPoller(client, providerId)
+		poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to become unregistered: %+v", providerId, err)
+		}
+	}
+
+	return nil
+}
+
+// listRegistered returns the Resource Providers requiring registration which are registered within the Subscription,
+// keyed by the lower-cased namespace
+func (r ResourceProviderRegistrationSetResource) listRegistered(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.SubscriptionId) (map[string]string, error) {
+	resp, err := metadata.Client.Resource.ResourceProvidersClient.ListComplete(ctx, id, providers.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Providers for %s: %+v", id, err)
+	}
+
+	registered := make(map[string]string)
+	for _, item := range resp.Items {
+		if item.Namespace == nil || item.RegistrationState == nil {
+			continue
+		}
+
+		// Resource Providers which don't require registration are always available and can't be unregistered
+		if item.RegistrationPolicy != nil && !strings.EqualFold(*item.RegistrationPolicy, "RegistrationRequired") {
+			continue
+		}
+
+		if strings.EqualFold(*item.RegistrationState, Registered) || strings.EqualFold(*item.RegistrationState, Registering) {
+			registered[strings.ToLower(*item.Namespace)] = *item.Namespace
+		}
+	}
+
+	return registered, nil
+}
+
+// isAutomaticallyRegistered returns whether the Resource Provider is registered by the Provider itself, in which case
+// it's left registered rather than being unregistered as an unmanaged Resource Provider
+func (r ResourceProviderRegistrationSetResource) isAutomaticallyRegistered(metadata sdk.ResourceMetaData, name string) bool {
+	if metadata.Client.Account.SkipResourceProviderRegistration {
+		return false
+	}
+
+	for resourceProvider := range resourceproviders.Required() {
+		if strings.EqualFold(resourceProvider, name) {
+			return true
+		}
+	}
+
+	return false
+}
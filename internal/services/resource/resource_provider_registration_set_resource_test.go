@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceProviderRegistrationSetResource struct{}
+
+func TestAccResourceProviderRegistrationSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_provider_registration_set", "test")
+	r := ResourceProviderRegistrationSetResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic("Microsoft.BlockchainTokens", "Microsoft.AgFoodPlatform"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_providers.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic("Microsoft.BlockchainTokens", "Microsoft.AgFoodPlatform", "Microsoft.ApiSecurity"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_providers.#").HasValue("3"),
+			),
+		},
+	})
+}
+
+func (ResourceProviderRegistrationSetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.ResourceProvidersClient.ListComplete(ctx, *id, providers.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Providers for %s: %+v", *id, err)
+	}
+
+	registered := make(map[string]struct{})
+	for _, item := range resp.Items {
+		if item.Namespace != nil && item.RegistrationState != nil && strings.EqualFold(*item.RegistrationState, "Registered") {
+			registered[strings.ToLower(*item.Namespace)] = struct{}{}
+		}
+	}
+
+	for key, name := range state.Attributes {
+		if !strings.HasPrefix(key, "resource_providers.") || key == "resource_providers.#" {
+			continue
+		}
+		if _, ok := registered[strings.ToLower(name)]; !ok {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ResourceProviderRegistrationSetResource) basic(names ...string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  skip_provider_registration = true
+}
+
+resource "azurerm_resource_provider_registration_set" "test" {
+  resource_providers = ["%s"]
+}
+`, strings.Join(names, `", "`))
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = TemplateSpecResource{}
+
+type TemplateSpecResource struct{}
+
+type TemplateSpecResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	Description       string            `tfschema:"description"`
+	DisplayName       string            `tfschema:"display_name"`
+	Metadata          string            `tfschema:"metadata"`
+	Tags              map[string]string `tfschema:"tags"`
+	Versions          []string          `tfschema:"versions"`
+}
+
+func (r TemplateSpecResource) ResourceType() string {
+	return "azurerm_template_spec"
+}
+
+func (r TemplateSpecResource) ModelObject() interface{} {
+	return &TemplateSpecResourceModel{}
+}
+
+func (r TemplateSpecResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return templatespecs.ValidateTemplateSpecID
+}
+
+func (r TemplateSpecResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TemplateSpecName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+
+		"metadata": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r TemplateSpecResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"versions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r TemplateSpecResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config TemplateSpecResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := templatespecs.NewTemplateSpecID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id, templatespecs.DefaultGetOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := templatespecs.TemplateSpec{
+				Location:   location.Normalize(config.Location),
+				Properties: &templatespecs.TemplateSpecProperties{},
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if config.Description != "" {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if config.DisplayName != "" {
+				payload.Properties.DisplayName = pointer.To(config.DisplayName)
+			}
+
+			if config.Metadata != "" {
+				metadataValue, err := expandTemplateDeploymentBody(config.Metadata)
+				if err != nil {
+					return fmt.Errorf("expanding `metadata`: %+v", err)
+				}
+				payload.Properties.Metadata = pointer.To(interface{}(*metadataValue))
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsClient
+
+			id, err := templatespecs.ParseTemplateSpecID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := templatespecs.GetOperationOptions{
+				Expand: pointer.To(templatespecs.TemplateSpecExpandKindVersions),
+			}
+			resp, err := client.Get(ctx, *id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := TemplateSpecResourceModel{
+				Name:              id.TemplateSpecName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = pointer.From(props.DisplayName)
+
+					if props.Metadata != nil {
+						metadataValue, err := flattenTemplateDeploymentBody(*props.Metadata)
+						if err != nil {
+							return fmt.Errorf("flattening `metadata`: %+v", err)
+						}
+						state.Metadata = pointer.From(metadataValue)
+					}
+
+					versions := make([]string, 0)
+					for name := range pointer.From(props.Versions) {
+						versions = append(versions, name)
+					}
+					sort.Strings(versions)
+					state.Versions = versions
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TemplateSpecResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsClient
+
+			id, err := templatespecs.ParseTemplateSpecID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config TemplateSpecResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id, templatespecs.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &templatespecs.TemplateSpecProperties{}
+			}
+			// versions are managed through `azurerm_template_spec_version` and are read-only on the parent
+			payload.Properties.Versions = nil
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = pointer.To(config.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				payload.Properties.Metadata = nil
+				if config.Metadata != "" {
+					metadataValue, err := expandTemplateDeploymentBody(config.Metadata)
+					if err != nil {
+						return fmt.Errorf("expanding `metadata`: %+v", err)
+					}
+					payload.Properties.Metadata = pointer.To(interface{}(*metadataValue))
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsClient
+
+			id, err := templatespecs.ParseTemplateSpecID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type TemplateSpecVersionResource struct{}
+
+func TestAccTemplateSpecVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTemplateSpecVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_template.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (TemplateSpecVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := templatespecversions.ParseTemplateSpecVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.TemplateSpecsVersionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (TemplateSpecVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsv-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_spec" "test" {
+  name                = "acctest-ts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r TemplateSpecVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+
+  template_body = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    resources      = []
+  })
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "import" {
+  name             = azurerm_template_spec_version.test.name
+  template_spec_id = azurerm_template_spec_version.test.template_spec_id
+  template_body    = azurerm_template_spec_version.test.template_body
+}
+`, r.basic(data))
+}
+
+func (r TemplateSpecVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  description      = "Acceptance Test Template Spec Version"
+
+  template_body = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    resources = [
+      {
+        type       = "Microsoft.Resources/deployments"
+        apiVersion = "2022-09-01"
+        name       = "linked"
+        properties = {
+          mode = "Incremental"
+          templateLink = {
+            relativePath = "artifacts/linked.json"
+          }
+        }
+      }
+    ]
+  })
+
+  linked_template {
+    path = "artifacts/linked.json"
+    template_body = jsonencode({
+      "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+      contentVersion = "1.0.0.0"
+      resources      = []
+    })
+  }
+
+  ui_form_definition = jsonencode({
+    "$schema" = "https://schema.management.azure.com/schemas/2021-09-09/uiFormDefinition.schema.json"
+    view = {
+      kind = "Form"
+      properties = {
+        title = "Acceptance Test"
+        steps = [
+          {
+            name     = "basics"
+            label    = "Basics"
+            elements = []
+          }
+        ]
+      }
+    }
+  })
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
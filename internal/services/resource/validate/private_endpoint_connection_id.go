@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+)
+
+// PrivateEndpointConnectionID validates that the specified ID is the ID of a Private Endpoint Connection to an Azure Resource
+func PrivateEndpointConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parse.PrivateEndpointConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("can not parse %q as a Private Endpoint Connection ID: %v", k, err))
+		return
+	}
+
+	return warnings, errors
+}
@@ -102,6 +102,13 @@ func authorizationRuleSchemaFrom(s map[string]*pluginsdk.Schema) map[string]*plu
 		Computed:  true,
 		Sensitive: true,
 	}
+	s["rotation_triggers"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
 	return s
 }
 
@@ -104,6 +104,15 @@ func resourceServiceBusNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resou
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+		for _, keyType := range []namespacesauthorizationrule.KeyType{namespacesauthorizationrule.KeyTypePrimaryKey, namespacesauthorizationrule.KeyTypeSecondaryKey} {
+			if _, err := client.NamespacesRegenerateKeys(ctx, id, namespacesauthorizationrule.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+			}
+		}
+	}
+
 	d.SetId(id.ID())
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
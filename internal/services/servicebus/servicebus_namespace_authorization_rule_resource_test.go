@@ -105,6 +105,32 @@ func TestAccServiceBusNamespaceAuthorizationRule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusNamespaceAuthorizationRule_rotationTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
+	r := ServiceBusNamespaceAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTriggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+		{
+			Config: r.rotationTriggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_triggers"),
+	})
+}
+
 func (t ServiceBusNamespaceAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := namespacesauthorizationrule.ParseAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -216,3 +242,36 @@ resource "azurerm_servicebus_namespace_authorization_rule" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
+
+func (ServiceBusNamespaceAuthorizationRuleResource) rotationTriggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+  name         = "acctest-%[1]d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+
+  listen = true
+  send   = true
+  manage = false
+
+  rotation_triggers = {
+    rotation = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, trigger)
+}
@@ -100,6 +100,15 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	// both keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if !d.IsNewResource() && d.HasChange("rotation_triggers") {
+		for _, keyType := range []queuesauthorizationrule.KeyType{queuesauthorizationrule.KeyTypePrimaryKey, queuesauthorizationrule.KeyTypeSecondaryKey} {
+			if _, err := client.QueuesRegenerateKeys(ctx, id, queuesauthorizationrule.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+			}
+		}
+	}
+
 	d.SetId(id.ID())
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
 	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
@@ -492,6 +492,10 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
 						},
+						"default_share_level_permission": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 						"active_directory": {
 							Type:     pluginsdk.TypeList,
 							Computed: true,
@@ -105,6 +105,13 @@ func resourceStorageAccountNetworkRulesSchema() map[string]*pluginsdk.Schema {
 			}, false),
 		},
 
+		"append_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
 		"private_link_access": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -161,7 +168,8 @@ func resourceStorageAccountNetworkRulesCreateUpdate(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
 	}
 
-	if d.IsNewResource() {
+	appendEnabled := d.Get("append_enabled").(bool)
+	if d.IsNewResource() && !appendEnabled {
 		if storageAccount.AccountProperties == nil {
 			return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): `properties` was nil", storageAccountName, resourceGroup)
 		}
@@ -176,11 +184,30 @@ func resourceStorageAccountNetworkRulesCreateUpdate(d *pluginsdk.ResourceData, m
 		rules = &storage.NetworkRuleSet{}
 	}
 
+	ipRules := expandStorageAccountNetworkRuleIpRules(d.Get("ip_rules").(*pluginsdk.Set).List())
+	virtualNetworkRules := expandStorageAccountNetworkRuleVirtualRules(d.Get("virtual_network_subnet_ids").(*pluginsdk.Set).List())
+	resourceAccessRules := expandStorageAccountPrivateLinkAccess(d.Get("private_link_access").([]interface{}), tenantId)
+
 	rules.DefaultAction = storage.DefaultAction(d.Get("default_action").(string))
-	rules.Bypass = expandStorageAccountNetworkRuleBypass(d.Get("bypass").(*pluginsdk.Set).List())
-	rules.IPRules = expandStorageAccountNetworkRuleIpRules(d.Get("ip_rules").(*pluginsdk.Set).List())
-	rules.VirtualNetworkRules = expandStorageAccountNetworkRuleVirtualRules(d.Get("virtual_network_subnet_ids").(*pluginsdk.Set).List())
-	rules.ResourceAccessRules = expandStorageAccountPrivateLinkAccess(d.Get("private_link_access").([]interface{}), tenantId)
+	if bypass := d.Get("bypass").(*pluginsdk.Set).List(); !appendEnabled || len(bypass) > 0 {
+		rules.Bypass = expandStorageAccountNetworkRuleBypass(bypass)
+	}
+
+	if appendEnabled {
+		// only the rules previously added by this resource are replaced, so that any rules managed elsewhere
+		// (e.g. by the Storage Account or by another instance of this resource) are retained
+		oldIpRules, _ := d.GetChange("ip_rules")
+		oldVirtualNetworkRules, _ := d.GetChange("virtual_network_subnet_ids")
+		oldResourceAccessRules, _ := d.GetChange("private_link_access")
+
+		ipRules = mergeStorageAccountNetworkRuleIpRules(rules.IPRules, expandStorageAccountNetworkRuleIpRules(oldIpRules.(*pluginsdk.Set).List()), ipRules)
+		virtualNetworkRules = mergeStorageAccountNetworkRuleVirtualRules(rules.VirtualNetworkRules, expandStorageAccountNetworkRuleVirtualRules(oldVirtualNetworkRules.(*pluginsdk.Set).List()), virtualNetworkRules)
+		resourceAccessRules = mergeStorageAccountResourceAccessRules(rules.ResourceAccessRules, expandStorageAccountPrivateLinkAccess(oldResourceAccessRules.([]interface{}), tenantId), resourceAccessRules)
+	}
+
+	rules.IPRules = ipRules
+	rules.VirtualNetworkRules = virtualNetworkRules
+	rules.ResourceAccessRules = resourceAccessRules
 
 	opts := storage.AccountUpdateParameters{
 		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -219,18 +246,33 @@ func resourceStorageAccountNetworkRulesRead(d *pluginsdk.ResourceData, meta inte
 
 	d.Set("storage_account_id", d.Id())
 
+	appendEnabled := d.Get("append_enabled").(bool)
+
 	if rules := storageAccount.NetworkRuleSet; rules != nil {
-		if err := d.Set("ip_rules", pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountIPRules(rules.IPRules))); err != nil {
+		ipRules := flattenStorageAccountIPRules(rules.IPRules)
+		virtualNetworkRules := flattenStorageAccountVirtualNetworks(rules.VirtualNetworkRules)
+		resourceAccessRules := flattenStorageAccountPrivateLinkAccess(rules.ResourceAccessRules)
+
+		if appendEnabled {
+			// only the rules added by this resource are tracked, rules managed elsewhere are ignored
+			ipRules = filterStorageAccountNetworkRules(ipRules, d.Get("ip_rules").(*pluginsdk.Set).List())
+			virtualNetworkRules = filterStorageAccountNetworkRules(virtualNetworkRules, d.Get("virtual_network_subnet_ids").(*pluginsdk.Set).List())
+			resourceAccessRules = filterStorageAccountPrivateLinkAccess(resourceAccessRules, d.Get("private_link_access").([]interface{}))
+		}
+
+		if err := d.Set("ip_rules", pluginsdk.NewSet(pluginsdk.HashString, ipRules)); err != nil {
 			return fmt.Errorf("setting `ip_rules`: %+v", err)
 		}
-		if err := d.Set("virtual_network_subnet_ids", pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountVirtualNetworks(rules.VirtualNetworkRules))); err != nil {
+		if err := d.Set("virtual_network_subnet_ids", pluginsdk.NewSet(pluginsdk.HashString, virtualNetworkRules)); err != nil {
 			return fmt.Errorf("setting `virtual_network_subnet_ids`: %+v", err)
 		}
 		if err := d.Set("bypass", pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountBypass(rules.Bypass))); err != nil {
 			return fmt.Errorf("setting `bypass`: %+v", err)
 		}
 		d.Set("default_action", string(rules.DefaultAction))
-		if err := d.Set("private_link_access", flattenStorageAccountPrivateLinkAccess(rules.ResourceAccessRules)); err != nil {
+		// the API doesn't guarantee the order of the resource access rules (e.g. when rules for Synapse Workspaces
+		// or Data Factories are added elsewhere), so they're returned in the order they're configured in
+		if err := d.Set("private_link_access", orderStorageAccountPrivateLinkAccess(resourceAccessRules, d.Get("private_link_access").([]interface{}))); err != nil {
 			return fmt.Errorf("setting `private_link_access`: %+v", err)
 		}
 	}
@@ -264,6 +306,25 @@ func resourceStorageAccountNetworkRulesDelete(d *pluginsdk.ResourceData, meta in
 		return nil
 	}
 
+	if d.Get("append_enabled").(bool) {
+		// only the rules added by this resource are removed, the rules managed elsewhere and the default action are retained
+		rules := storageAccount.NetworkRuleSet
+		rules.IPRules = mergeStorageAccountNetworkRuleIpRules(rules.IPRules, expandStorageAccountNetworkRuleIpRules(d.Get("ip_rules").(*pluginsdk.Set).List()), nil)
+		rules.VirtualNetworkRules = mergeStorageAccountNetworkRuleVirtualRules(rules.VirtualNetworkRules, expandStorageAccountNetworkRuleVirtualRules(d.Get("virtual_network_subnet_ids").(*pluginsdk.Set).List()), nil)
+		rules.ResourceAccessRules = mergeStorageAccountResourceAccessRules(rules.ResourceAccessRules, expandStorageAccountPrivateLinkAccess(d.Get("private_link_access").([]interface{}), meta.(*clients.Client).Account.TenantId), nil)
+
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				NetworkRuleSet: rules,
+			},
+		}
+		if _, err := client.Update(ctx, parsedStorageAccountNetworkRuleId.ResourceGroupName, parsedStorageAccountNetworkRuleId.StorageAccountName, opts); err != nil {
+			return fmt.Errorf("deleting Azure %s: %+v", *parsedStorageAccountNetworkRuleId, err)
+		}
+
+		return nil
+	}
+
 	// We can't delete a network rule set so we'll just update it back to the default instead
 	virtualNetworkRules := make([]storage.VirtualNetworkRule, 0)
 	ipRules := make([]storage.IPRule, 0)
@@ -339,3 +400,188 @@ func expandStorageAccountNetworkRuleVirtualRules(virtualNetworkInfo []interface{
 
 	return &virtualNetworks
 }
+
+// mergeStorageAccountNetworkRuleIpRules returns the existing IP Rules without those being removed, followed by those being added
+func mergeStorageAccountNetworkRuleIpRules(existing *[]storage.IPRule, removed *[]storage.IPRule, added *[]storage.IPRule) *[]storage.IPRule {
+	key := func(input storage.IPRule) string {
+		if input.IPAddressOrRange == nil {
+			return ""
+		}
+		return *input.IPAddressOrRange
+	}
+
+	skip := make(map[string]bool)
+	if removed != nil {
+		for _, v := range *removed {
+			skip[key(v)] = true
+		}
+	}
+	if added != nil {
+		for _, v := range *added {
+			skip[key(v)] = true
+		}
+	}
+
+	output := make([]storage.IPRule, 0)
+	if existing != nil {
+		for _, v := range *existing {
+			if !skip[key(v)] {
+				output = append(output, v)
+			}
+		}
+	}
+	if added != nil {
+		output = append(output, *added...)
+	}
+
+	return &output
+}
+
+// mergeStorageAccountNetworkRuleVirtualRules returns the existing Virtual Network Rules without those being removed, followed by those being added
+func mergeStorageAccountNetworkRuleVirtualRules(existing *[]storage.VirtualNetworkRule, removed *[]storage.VirtualNetworkRule, added *[]storage.VirtualNetworkRule) *[]storage.VirtualNetworkRule {
+	key := func(input storage.VirtualNetworkRule) string {
+		if input.VirtualNetworkResourceID == nil {
+			return ""
+		}
+		return strings.ToLower(*input.VirtualNetworkResourceID)
+	}
+
+	skip := make(map[string]bool)
+	if removed != nil {
+		for _, v := range *removed {
+			skip[key(v)] = true
+		}
+	}
+	if added != nil {
+		for _, v := range *added {
+			skip[key(v)] = true
+		}
+	}
+
+	output := make([]storage.VirtualNetworkRule, 0)
+	if existing != nil {
+		for _, v := range *existing {
+			if !skip[key(v)] {
+				output = append(output, v)
+			}
+		}
+	}
+	if added != nil {
+		output = append(output, *added...)
+	}
+
+	return &output
+}
+
+// mergeStorageAccountResourceAccessRules returns the existing Resource Access Rules without those being removed, followed by those being added
+func mergeStorageAccountResourceAccessRules(existing *[]storage.ResourceAccessRule, removed *[]storage.ResourceAccessRule, added *[]storage.ResourceAccessRule) *[]storage.ResourceAccessRule {
+	key := func(input storage.ResourceAccessRule) string {
+		var resourceId, tenantId string
+		if input.ResourceID != nil {
+			resourceId = *input.ResourceID
+		}
+		if input.TenantID != nil {
+			tenantId = *input.TenantID
+		}
+		return strings.ToLower(fmt.Sprintf("%s|%s", resourceId, tenantId))
+	}
+
+	skip := make(map[string]bool)
+	if removed != nil {
+		for _, v := range *removed {
+			skip[key(v)] = true
+		}
+	}
+	if added != nil {
+		for _, v := range *added {
+			skip[key(v)] = true
+		}
+	}
+
+	output := make([]storage.ResourceAccessRule, 0)
+	if existing != nil {
+		for _, v := range *existing {
+			if !skip[key(v)] {
+				output = append(output, v)
+			}
+		}
+	}
+	if added != nil {
+		output = append(output, *added...)
+	}
+
+	return &output
+}
+
+func filterStorageAccountNetworkRules(input []interface{}, tracked []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		for _, t := range tracked {
+			if strings.EqualFold(v.(string), t.(string)) {
+				output = append(output, v)
+				break
+			}
+		}
+	}
+
+	return output
+}
+
+func filterStorageAccountPrivateLinkAccess(input []interface{}, tracked []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		rule := v.(map[string]interface{})
+		for _, t := range tracked {
+			if t == nil {
+				continue
+			}
+			trackedRule := t.(map[string]interface{})
+			if !strings.EqualFold(rule["endpoint_resource_id"].(string), trackedRule["endpoint_resource_id"].(string)) {
+				continue
+			}
+			if tenantId := trackedRule["endpoint_tenant_id"].(string); tenantId != "" && !strings.EqualFold(rule["endpoint_tenant_id"].(string), tenantId) {
+				continue
+			}
+			output = append(output, v)
+			break
+		}
+	}
+
+	return output
+}
+
+// orderStorageAccountPrivateLinkAccess returns the Resource Access Rules in the order they're configured in, followed by any
+// Resource Access Rules which aren't configured
+func orderStorageAccountPrivateLinkAccess(input []interface{}, configured []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	used := make([]bool, len(input))
+	for _, c := range configured {
+		if c == nil {
+			continue
+		}
+		configuredRule := c.(map[string]interface{})
+		for i, v := range input {
+			if used[i] {
+				continue
+			}
+			rule := v.(map[string]interface{})
+			if !strings.EqualFold(rule["endpoint_resource_id"].(string), configuredRule["endpoint_resource_id"].(string)) {
+				continue
+			}
+			if tenantId := configuredRule["endpoint_tenant_id"].(string); tenantId != "" && !strings.EqualFold(rule["endpoint_tenant_id"].(string), tenantId) {
+				continue
+			}
+			used[i] = true
+			output = append(output, v)
+			break
+		}
+	}
+
+	for i, v := range input {
+		if !used[i] {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
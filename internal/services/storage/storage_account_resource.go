@@ -204,6 +204,18 @@ func resourceStorageAccount() *pluginsdk.Resource {
 							}, false),
 						},
 
+						"default_share_level_permission": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.DefaultSharePermissionNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.DefaultSharePermissionNone),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareReader),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareContributor),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareElevatedContributor),
+							}, false),
+						},
+
 						"active_directory": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -1271,6 +1283,14 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": {
 				Type:         pluginsdk.TypeMap,
 				Optional:     true,
@@ -1901,8 +1921,9 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	// azure_files_authentication must be the last to be updated, cause it'll occupy the storage account for several minutes after receiving the response 200 OK. Issue: https://github.com/Azure/azure-rest-api-specs/issues/11272
 	if d.HasChange("azure_files_authentication") {
 		// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing its DirectoryServiceOptions
+		// this is only needed when switching from one directory service to another, rather than when enabling or disabling it
 		old, new := d.GetChange("azure_files_authentication.0.directory_type")
-		if old != new && new != string(storage.DirectoryServiceOptionsNone) {
+		if old != new && old.(string) != "" && new.(string) != "" && new != string(storage.DirectoryServiceOptionsNone) {
 			log.Print("[DEBUG] Disabling AzureFilesIdentityBasedAuthentication prior to changing DirectoryServiceOptions")
 			dsNone := storage.AccountUpdateParameters{
 				AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -2058,6 +2079,21 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// both access keys are regenerated when the `rotation_triggers` change, allowing the keys to be rotated from within Terraform
+	if d.HasChange("rotation_triggers") {
+		for _, keyName := range []string{"key1", "key2"} {
+			parameters := storage.AccountRegenerateKeyParameters{
+				KeyName: utils.String(keyName),
+			}
+			if _, err := client.RegenerateKey(ctx, id.ResourceGroupName, id.StorageAccountName, parameters); err != nil {
+				return fmt.Errorf("regenerating the access key %q for %s: %+v", keyName, *id, err)
+			}
+		}
+
+		// the cached account key is no longer valid for the Data Plane clients
+		meta.(*clients.Client).Storage.RemoveAccountFromCache(*id)
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -2695,9 +2731,19 @@ func expandArmStorageAccountAzureFilesAuthentication(input []interface{}) (*stor
 		}
 	}
 
+	// Microsoft Entra Kerberos only uses the (optional) domain name and GUID of the on-premises domain, the other properties
+	// may remain in the state after switching from `AD` and are rejected by the API, so aren't sent
+	if string(directoryOption) == string(storageaccounts.DirectoryServiceOptionsAADKERB) && ad != nil {
+		ad = &storage.ActiveDirectoryProperties{
+			DomainGUID: ad.DomainGUID,
+			DomainName: ad.DomainName,
+		}
+	}
+
 	return &storage.AzureFilesIdentityBasedAuthentication{
 		DirectoryServiceOptions:   directoryOption,
 		ActiveDirectoryProperties: ad,
+		DefaultSharePermission:    storage.DefaultSharePermission(v["default_share_level_permission"].(string)),
 	}, nil
 }
 
@@ -3240,10 +3286,16 @@ func flattenArmStorageAccountAzureFilesAuthentication(input *storage.AzureFilesI
 		return make([]interface{}, 0)
 	}
 
+	defaultSharePermission := string(storage.DefaultSharePermissionNone)
+	if input.DefaultSharePermission != "" {
+		defaultSharePermission = string(input.DefaultSharePermission)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"directory_type":   input.DirectoryServiceOptions,
-			"active_directory": flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"directory_type":                 input.DirectoryServiceOptions,
+			"active_directory":               flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"default_share_level_permission": defaultSharePermission,
 		},
 	}
 }
@@ -111,7 +111,7 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 								Schema: map[string]*pluginsdk.Schema{
 									"blob_types": {
 										Type:     pluginsdk.TypeSet,
-										Required: true,
+										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type: pluginsdk.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
@@ -170,9 +170,8 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
 			rules := diff.Get("rules").(*pluginsdk.Set).List()
 			for _, rule := range rules {
-				v := rule.(map[string]interface{})
-				if v["scope"] != string(blobinventorypolicies.ObjectTypeBlob) && len(v["filter"].([]interface{})) != 0 {
-					return fmt.Errorf("the `filter` can only be set when the `scope` is `%s`", blobinventorypolicies.ObjectTypeBlob)
+				if err := validateBlobInventoryPolicyRule(rule.(map[string]interface{})); err != nil {
+					return err
 				}
 			}
 
@@ -181,6 +180,84 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 	}
 }
 
+// validateBlobInventoryPolicyRule checks the filter and schema fields of a rule against its scope, since the API only
+// rejects an unsupported combination once the inventory runs, rather than when the policy is created
+func validateBlobInventoryPolicyRule(rule map[string]interface{}) error {
+	name := rule["name"].(string)
+	scope := rule["scope"].(string)
+
+	filter := map[string]interface{}{}
+	if filters := rule["filter"].([]interface{}); len(filters) > 0 && filters[0] != nil {
+		filter = filters[0].(map[string]interface{})
+	}
+	blobTypes := 0
+	if v, ok := filter["blob_types"].(*pluginsdk.Set); ok {
+		blobTypes = v.Len()
+	}
+	includeBlobVersions, _ := filter["include_blob_versions"].(bool)
+	includeDeleted, _ := filter["include_deleted"].(bool)
+	includeSnapshots, _ := filter["include_snapshots"].(bool)
+
+	switch blobinventorypolicies.ObjectType(scope) {
+	case blobinventorypolicies.ObjectTypeBlob:
+		if len(filter) > 0 && blobTypes == 0 {
+			return fmt.Errorf("rule %q: `filter.0.blob_types` must be specified when the `scope` is `%s`", name, blobinventorypolicies.ObjectTypeBlob)
+		}
+
+	case blobinventorypolicies.ObjectTypeContainer:
+		if blobTypes > 0 || includeBlobVersions || includeSnapshots {
+			return fmt.Errorf("rule %q: only `filter.0.prefix_match`, `filter.0.exclude_prefixes` and `filter.0.include_deleted` can be specified when the `scope` is `%s`", name, blobinventorypolicies.ObjectTypeContainer)
+		}
+	}
+
+	fields := make([]string, 0)
+	for _, field := range rule["schema_fields"].([]interface{}) {
+		v, _ := field.(string)
+		if v == "" {
+			// the schema fields aren't known until apply, so can't be validated
+			return nil
+		}
+		fields = append(fields, v)
+	}
+
+	if err := validate.StorageBlobInventoryPolicySchemaFields(scope, fields); err != nil {
+		return fmt.Errorf("rule %q: %+v", name, err)
+	}
+
+	// the filter options are only applied when the schema fields they populate are included
+	required := make([]string, 0)
+	if blobTypes > 0 {
+		required = append(required, "BlobType")
+	}
+	if includeBlobVersions {
+		required = append(required, "VersionId", "IsCurrentVersion")
+	}
+	if includeSnapshots {
+		required = append(required, "Snapshot")
+	}
+	if includeDeleted {
+		if scope == string(blobinventorypolicies.ObjectTypeContainer) {
+			required = append(required, "Deleted", "Version", "DeletedTime", "RemainingRetentionDays")
+		} else {
+			required = append(required, "Deleted", "RemainingRetentionDays")
+		}
+	}
+	for _, field := range required {
+		found := false
+		for _, v := range fields {
+			if v == field {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("rule %q: the schema field %q must be specified when using the `filter` options specified for this rule", name, field)
+		}
+	}
+
+	return nil
+}
+
 func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Storage.ResourceManager.BlobInventoryPolicies
@@ -289,26 +366,32 @@ func expandBlobInventoryPolicyRules(input []interface{}) []blobinventorypolicies
 				Schedule:     blobinventorypolicies.Schedule(v["schedule"].(string)),
 				ObjectType:   blobinventorypolicies.ObjectType(v["scope"].(string)),
 				SchemaFields: *utils.ExpandStringSlice(v["schema_fields"].([]interface{})),
-				Filters:      expandBlobInventoryPolicyFilter(v["filter"].([]interface{})),
+				Filters:      expandBlobInventoryPolicyFilter(v["scope"].(string), v["filter"].([]interface{})),
 			},
 		})
 	}
 	return results
 }
 
-func expandBlobInventoryPolicyFilter(input []interface{}) *blobinventorypolicies.BlobInventoryPolicyFilter {
-	if len(input) == 0 {
+func expandBlobInventoryPolicyFilter(scope string, input []interface{}) *blobinventorypolicies.BlobInventoryPolicyFilter {
+	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	return &blobinventorypolicies.BlobInventoryPolicyFilter{
-		PrefixMatch:         utils.ExpandStringSlice(v["prefix_match"].(*pluginsdk.Set).List()),
-		ExcludePrefix:       utils.ExpandStringSlice(v["exclude_prefixes"].(*pluginsdk.Set).List()),
-		BlobTypes:           utils.ExpandStringSlice(v["blob_types"].(*pluginsdk.Set).List()),
-		IncludeBlobVersions: utils.Bool(v["include_blob_versions"].(bool)),
-		IncludeDeleted:      utils.Bool(v["include_deleted"].(bool)),
-		IncludeSnapshots:    utils.Bool(v["include_snapshots"].(bool)),
+	filter := &blobinventorypolicies.BlobInventoryPolicyFilter{
+		PrefixMatch:    utils.ExpandStringSlice(v["prefix_match"].(*pluginsdk.Set).List()),
+		ExcludePrefix:  utils.ExpandStringSlice(v["exclude_prefixes"].(*pluginsdk.Set).List()),
+		IncludeDeleted: utils.Bool(v["include_deleted"].(bool)),
 	}
+
+	// the blob types, blob versions and snapshots are only supported when the scope is `Blob`
+	if scope == string(blobinventorypolicies.ObjectTypeBlob) {
+		filter.BlobTypes = utils.ExpandStringSlice(v["blob_types"].(*pluginsdk.Set).List())
+		filter.IncludeBlobVersions = utils.Bool(v["include_blob_versions"].(bool))
+		filter.IncludeSnapshots = utils.Bool(v["include_snapshots"].(bool))
+	}
+
+	return filter
 }
 
 func flattenBlobInventoryPolicyRules(input []blobinventorypolicies.BlobInventoryPolicyRule) []interface{} {
@@ -6,6 +6,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -96,6 +97,31 @@ func TestAccStorageBlobInventoryPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlobInventoryPolicy_containerScopeFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerScopeFilter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageBlobInventoryPolicy_unsupportedSchemaField(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unsupportedSchemaField(data),
+			ExpectError: regexp.MustCompile("the schema field \"Creation-Time\" is not supported when the scope is \"Container\""),
+		},
+	})
+}
+
 func (r StorageBlobInventoryPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseStorageAccountID(state.ID)
 	if err != nil {
@@ -280,3 +306,56 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
 }
 `, template)
 }
+
+func (r StorageBlobInventoryPolicyResource) containerScopeFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rules {
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Csv"
+    schedule               = "Daily"
+    scope                  = "Container"
+    schema_fields = [
+      "Name",
+      "Last-Modified",
+      "Deleted",
+      "Version",
+      "DeletedTime",
+      "RemainingRetentionDays",
+    ]
+    filter {
+      include_deleted  = true
+      prefix_match     = ["logs"]
+      exclude_prefixes = ["logs-archive"]
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageBlobInventoryPolicyResource) unsupportedSchemaField(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rules {
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Csv"
+    schedule               = "Daily"
+    scope                  = "Container"
+    schema_fields = [
+      "Name",
+      "Creation-Time",
+    ]
+  }
+}
+`, r.template(data))
+}
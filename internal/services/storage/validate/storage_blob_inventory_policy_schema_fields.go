@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobinventorypolicies"
+)
+
+// the schema fields supported for each scope of a Blob Inventory Policy rule, see:
+// https://learn.microsoft.com/azure/storage/blobs/blob-inventory#custom-schema-fields-supported-for-blob-inventory
+var storageBlobInventoryPolicySchemaFields = map[blobinventorypolicies.ObjectType][]string{
+	blobinventorypolicies.ObjectTypeBlob: {
+		"Name",
+		"Creation-Time",
+		"Last-Modified",
+		"LastAccessTime",
+		"ETag",
+		"Content-Length",
+		"Content-Type",
+		"Content-Encoding",
+		"Content-Language",
+		"Content-CRC64",
+		"Content-MD5",
+		"Cache-Control",
+		"Content-Disposition",
+		"BlobType",
+		"AccessTier",
+		"AccessTierChangeTime",
+		"AccessTierInferred",
+		"LeaseStatus",
+		"LeaseState",
+		"LeaseDuration",
+		"ServerEncrypted",
+		"CustomerProvidedKeySha256",
+		"EncryptionScope",
+		"IncrementalCopy",
+		"x-ms-blob-sequence-number",
+		"Metadata",
+		"Tags",
+		"TagCount",
+		"Expiry-Time",
+		"hdi_isfolder",
+		"Owner",
+		"Group",
+		"Permissions",
+		"Acl",
+		"Snapshot",
+		"VersionId",
+		"IsCurrentVersion",
+		"Version",
+		"Deleted",
+		"DeletedTime",
+		"RemainingRetentionDays",
+		"DeletionId",
+		"CopyId",
+		"CopySource",
+		"CopyStatus",
+		"CopyProgress",
+		"CopyCompletionTime",
+		"CopyStatusDescription",
+		"ImmutabilityPolicyUntilDate",
+		"ImmutabilityPolicyMode",
+		"LegalHold",
+		"RehydratePriority",
+		"ArchiveStatus",
+	},
+	blobinventorypolicies.ObjectTypeContainer: {
+		"Name",
+		"Last-Modified",
+		"ETag",
+		"LeaseStatus",
+		"LeaseState",
+		"LeaseDuration",
+		"Metadata",
+		"PublicAccess",
+		"DefaultEncryptionScope",
+		"DenyEncryptionScopeOverride",
+		"HasImmutabilityPolicy",
+		"HasLegalHold",
+		"ImmutableStorageWithVersioningEnabled",
+		"Deleted",
+		"Version",
+		"DeletedTime",
+		"RemainingRetentionDays",
+	},
+}
+
+// StorageBlobInventoryPolicySchemaFields validates that the schema fields of a Blob Inventory Policy rule are
+// supported for the scope of the rule, since the API doesn't reject unsupported fields until the policy is applied
+func StorageBlobInventoryPolicySchemaFields(scope string, fields []string) error {
+	supported, ok := storageBlobInventoryPolicySchemaFields[blobinventorypolicies.ObjectType(scope)]
+	if !ok {
+		return fmt.Errorf("unsupported scope %q", scope)
+	}
+
+	hasName := false
+	for _, field := range fields {
+		if field == "Name" {
+			hasName = true
+		}
+
+		found := false
+		for _, v := range supported {
+			if field == v {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		for _, v := range supported {
+			if strings.EqualFold(field, v) {
+				return fmt.Errorf("the schema field %q is not supported when the scope is %q - did you mean %q?", field, scope, v)
+			}
+		}
+		return fmt.Errorf("the schema field %q is not supported when the scope is %q, supported values are: %s", field, scope, strings.Join(supported, ", "))
+	}
+
+	if !hasName {
+		return fmt.Errorf("the schema field %q must be specified", "Name")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestStorageBlobInventoryPolicySchemaFields(t *testing.T) {
+	cases := []struct {
+		Scope  string
+		Fields []string
+		Valid  bool
+	}{
+		{
+			Scope:  "Blob",
+			Fields: []string{"Name", "Creation-Time", "BlobType", "Content-Length"},
+			Valid:  true,
+		},
+		{
+			Scope:  "Container",
+			Fields: []string{"Name", "Last-Modified", "PublicAccess", "HasLegalHold"},
+			Valid:  true,
+		},
+		{
+			// `Name` is required
+			Scope:  "Blob",
+			Fields: []string{"Creation-Time"},
+			Valid:  false,
+		},
+		{
+			// `Creation-Time` is only supported for blobs
+			Scope:  "Container",
+			Fields: []string{"Name", "Creation-Time"},
+			Valid:  false,
+		},
+		{
+			// `PublicAccess` is only supported for containers
+			Scope:  "Blob",
+			Fields: []string{"Name", "PublicAccess"},
+			Valid:  false,
+		},
+		{
+			// the fields are case-sensitive
+			Scope:  "Blob",
+			Fields: []string{"Name", "blobtype"},
+			Valid:  false,
+		},
+		{
+			Scope:  "Blob",
+			Fields: []string{"Name", "NotAField"},
+			Valid:  false,
+		},
+		{
+			Scope:  "Account",
+			Fields: []string{"Name"},
+			Valid:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q with %v", tc.Scope, tc.Fields)
+
+		err := StorageBlobInventoryPolicySchemaFields(tc.Scope, tc.Fields)
+		valid := err == nil
+		if valid != tc.Valid {
+			t.Fatalf("expected %t but got %t (%+v)", tc.Valid, valid, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/support/mgmt/2020-04-01/support" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	ProblemClassificationsClient *support.ProblemClassificationsClient
+	ServicesClient               *support.ServicesClient
+	TicketsClient                *support.TicketsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	problemClassificationsClient := support.NewProblemClassificationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&problemClassificationsClient.Client, o.ResourceManagerAuthorizer)
+
+	servicesClient := support.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
+
+	ticketsClient := support.NewTicketsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ticketsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ProblemClassificationsClient: &problemClassificationsClient,
+		ServicesClient:               &servicesClient,
+		TicketsClient:                &ticketsClient,
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ProblemClassificationId struct {
+	ServiceName string
+	Name        string
+}
+
+func NewProblemClassificationID(serviceName, name string) ProblemClassificationId {
+	return ProblemClassificationId{
+		ServiceName: serviceName,
+		Name:        name,
+	}
+}
+
+func (id ProblemClassificationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Problem Classification", segmentsStr)
+}
+
+func (id ProblemClassificationId) ID() string {
+	fmtString := "/providers/Microsoft.Support/services/%s/problemClassifications/%s"
+	return fmt.Sprintf(fmtString, id.ServiceName, id.Name)
+}
+
+// ProblemClassificationID parses a ProblemClassification ID into an ProblemClassificationId struct
+func ProblemClassificationID(input string) (*ProblemClassificationId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ProblemClassification ID: %+v", input, err)
+	}
+
+	resourceId := ProblemClassificationId{}
+
+	if resourceId.ServiceName, err = id.PopSegment("services"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("problemClassifications"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ProblemClassificationIDInsensitively parses an ProblemClassification ID into an ProblemClassificationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ProblemClassificationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ProblemClassificationIDInsensitively(input string) (*ProblemClassificationId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ProblemClassificationId{}
+
+	// find the correct casing for the 'services' segment
+	servicesKey := "services"
+	for key := range id.Path {
+		if strings.EqualFold(key, servicesKey) {
+			servicesKey = key
+			break
+		}
+	}
+	if resourceId.ServiceName, err = id.PopSegment(servicesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'problemClassifications' segment
+	problemClassificationsKey := "problemClassifications"
+	for key := range id.Path {
+		if strings.EqualFold(key, problemClassificationsKey) {
+			problemClassificationsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(problemClassificationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ProblemClassificationId{}
+
+func TestProblemClassificationIDFormatter(t *testing.T) {
+	actual := NewProblemClassificationID("service1", "problemClassification1").ID()
+	expected := "/providers/Microsoft.Support/services/service1/problemClassifications/problemClassification1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestProblemClassificationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ProblemClassificationId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing ServiceName
+			Input: "/providers/Microsoft.Support/",
+			Error: true,
+		},
+
+		{
+			// missing value for ServiceName
+			Input: "/providers/Microsoft.Support/services/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Support/services/service1/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/problemClassification1",
+			Expected: &ProblemClassificationId{
+				ServiceName: "service1",
+				Name:        "problemClassification1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.SUPPORT/SERVICES/SERVICE1/PROBLEMCLASSIFICATIONS/PROBLEMCLASSIFICATION1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ProblemClassificationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ServiceName != v.Expected.ServiceName {
+			t.Fatalf("Expected %q but got %q for ServiceName", v.Expected.ServiceName, actual.ServiceName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestProblemClassificationIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ProblemClassificationId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing ServiceName
+			Input: "/providers/Microsoft.Support/",
+			Error: true,
+		},
+
+		{
+			// missing value for ServiceName
+			Input: "/providers/Microsoft.Support/services/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Support/services/service1/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/problemClassification1",
+			Expected: &ProblemClassificationId{
+				ServiceName: "service1",
+				Name:        "problemClassification1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/providers/Microsoft.Support/services/service1/problemclassifications/problemClassification1",
+			Expected: &ProblemClassificationId{
+				ServiceName: "service1",
+				Name:        "problemClassification1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/providers/Microsoft.Support/SERVICES/service1/PROBLEMCLASSIFICATIONS/problemClassification1",
+			Expected: &ProblemClassificationId{
+				ServiceName: "service1",
+				Name:        "problemClassification1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/providers/Microsoft.Support/SeRvIcEs/service1/PrObLeMcLaSsIfIcAtIoNs/problemClassification1",
+			Expected: &ProblemClassificationId{
+				ServiceName: "service1",
+				Name:        "problemClassification1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ProblemClassificationIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ServiceName != v.Expected.ServiceName {
+			t.Fatalf("Expected %q but got %q for ServiceName", v.Expected.ServiceName, actual.ServiceName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ServiceId struct {
+	Name string
+}
+
+func NewServiceID(name string) ServiceId {
+	return ServiceId{
+		Name: name,
+	}
+}
+
+func (id ServiceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Service", segmentsStr)
+}
+
+func (id ServiceId) ID() string {
+	fmtString := "/providers/Microsoft.Support/services/%s"
+	return fmt.Sprintf(fmtString, id.Name)
+}
+
+// ServiceID parses a Service ID into an ServiceId struct
+func ServiceID(input string) (*ServiceId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an Service ID: %+v", input, err)
+	}
+
+	resourceId := ServiceId{}
+
+	if resourceId.Name, err = id.PopSegment("services"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ServiceIDInsensitively parses an Service ID into an ServiceId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ServiceID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ServiceIDInsensitively(input string) (*ServiceId, error) {
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServiceId{}
+
+	// find the correct casing for the 'services' segment
+	servicesKey := "services"
+	for key := range id.Path {
+		if strings.EqualFold(key, servicesKey) {
+			servicesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(servicesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ServiceId{}
+
+func TestServiceIDFormatter(t *testing.T) {
+	actual := NewServiceID("service1").ID()
+	expected := "/providers/Microsoft.Support/services/service1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestServiceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ServiceId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Support/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Support/services/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Support/services/service1",
+			Expected: &ServiceId{
+				Name: "service1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.SUPPORT/SERVICES/SERVICE1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ServiceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestServiceIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ServiceId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Support/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Support/services/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Support/services/service1",
+			Expected: &ServiceId{
+				Name: "service1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/providers/Microsoft.Support/services/service1",
+			Expected: &ServiceId{
+				Name: "service1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/providers/Microsoft.Support/SERVICES/service1",
+			Expected: &ServiceId{
+				Name: "service1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/providers/Microsoft.Support/SeRvIcEs/service1",
+			Expected: &ServiceId{
+				Name: "service1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ServiceIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SupportTicketId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func NewSupportTicketID(subscriptionId, name string) SupportTicketId {
+	return SupportTicketId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+func (id SupportTicketId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Support Ticket", segmentsStr)
+}
+
+func (id SupportTicketId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Support/supportTickets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// SupportTicketID parses a SupportTicket ID into an SupportTicketId struct
+func SupportTicketID(input string) (*SupportTicketId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SupportTicket ID: %+v", input, err)
+	}
+
+	resourceId := SupportTicketId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("supportTickets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
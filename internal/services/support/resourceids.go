@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SupportTicket -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Support/supportTickets/supportTicket1
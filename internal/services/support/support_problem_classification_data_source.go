@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/support/mgmt/2020-04-01/support" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SupportProblemClassificationDataSource struct{}
+
+var _ sdk.DataSource = SupportProblemClassificationDataSource{}
+
+type SupportProblemClassificationDataSourceModel struct {
+	ServiceId   string `tfschema:"service_id"`
+	DisplayName string `tfschema:"display_name"`
+	Name        string `tfschema:"name"`
+}
+
+func (SupportProblemClassificationDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ServiceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (SupportProblemClassificationDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (SupportProblemClassificationDataSource) ModelObject() interface{} {
+	return &SupportProblemClassificationDataSourceModel{}
+}
+
+func (SupportProblemClassificationDataSource) ResourceType() string {
+	return "azurerm_support_problem_classification"
+}
+
+func (SupportProblemClassificationDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.ProblemClassificationsClient
+
+			var state SupportProblemClassificationDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serviceId, err := parse.ServiceID(state.ServiceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.List(ctx, serviceId.Name)
+			if err != nil {
+				return fmt.Errorf("listing Problem Classifications for %s: %+v", *serviceId, err)
+			}
+
+			var problemClassification *support.ProblemClassification
+			for _, item := range pointer.From(resp.Value) {
+				if item.ProblemClassificationProperties != nil && strings.EqualFold(pointer.From(item.ProblemClassificationProperties.DisplayName), state.DisplayName) {
+					problemClassification = &item
+					break
+				}
+			}
+			if problemClassification == nil {
+				return fmt.Errorf("a Problem Classification with the display name %q was not found for %s", state.DisplayName, *serviceId)
+			}
+
+			id, err := parse.ProblemClassificationIDInsensitively(pointer.From(problemClassification.ID))
+			if err != nil {
+				return err
+			}
+
+			state.Name = id.Name
+			if props := problemClassification.ProblemClassificationProperties; props != nil {
+				state.DisplayName = pointer.From(props.DisplayName)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SupportProblemClassificationDataSource struct{}
+
+func TestAccSupportProblemClassificationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_support_problem_classification", "test")
+	d := SupportProblemClassificationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("name").Exists(),
+			),
+		},
+	})
+}
+
+func (SupportProblemClassificationDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_support_service" "test" {
+  display_name = "Service and subscription limits (quotas)"
+}
+
+data "azurerm_support_problem_classification" "test" {
+  service_id   = data.azurerm_support_service.test.id
+  display_name = "Compute-VM (cores-vCPUs) subscription limit increases"
+}
+`
+}
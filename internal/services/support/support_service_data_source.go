@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/support/mgmt/2020-04-01/support" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SupportServiceDataSource struct{}
+
+var _ sdk.DataSource = SupportServiceDataSource{}
+
+type SupportServiceDataSourceModel struct {
+	DisplayName   string   `tfschema:"display_name"`
+	Name          string   `tfschema:"name"`
+	ResourceTypes []string `tfschema:"resource_types"`
+}
+
+func (SupportServiceDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (SupportServiceDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_types": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (SupportServiceDataSource) ModelObject() interface{} {
+	return &SupportServiceDataSourceModel{}
+}
+
+func (SupportServiceDataSource) ResourceType() string {
+	return "azurerm_support_service"
+}
+
+func (SupportServiceDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.ServicesClient
+
+			var state SupportServiceDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.List(ctx)
+			if err != nil {
+				return fmt.Errorf("listing Support Services: %+v", err)
+			}
+
+			// the API only allows Services to be retrieved by their GUID, so they're looked up by display name instead
+			var service *support.Service
+			for _, item := range pointer.From(resp.Value) {
+				if item.ServiceProperties != nil && strings.EqualFold(pointer.From(item.ServiceProperties.DisplayName), state.DisplayName) {
+					service = &item
+					break
+				}
+			}
+			if service == nil {
+				return fmt.Errorf("a Support Service with the display name %q was not found", state.DisplayName)
+			}
+
+			id, err := parse.ServiceIDInsensitively(pointer.From(service.ID))
+			if err != nil {
+				return err
+			}
+
+			state.Name = id.Name
+			if props := service.ServiceProperties; props != nil {
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.ResourceTypes = pointer.From(props.ResourceTypes)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
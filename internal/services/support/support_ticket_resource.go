@@ -0,0 +1,596 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/support/mgmt/2020-04-01/support" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SupportTicketResource struct{}
+
+type SupportTicketModel struct {
+	Name                       string                 `tfschema:"name"`
+	Title                      string                 `tfschema:"title"`
+	Description                string                 `tfschema:"description"`
+	ServiceId                  string                 `tfschema:"service_id"`
+	ProblemClassificationId    string                 `tfschema:"problem_classification_id"`
+	Severity                   string                 `tfschema:"severity"`
+	Contact                    []SupportTicketContact `tfschema:"contact"`
+	ProblemStartTime           string                 `tfschema:"problem_start_time"`
+	Require24x7ResponseEnabled bool                   `tfschema:"require_24x7_response_enabled"`
+	TechnicalResourceId        string                 `tfschema:"technical_resource_id"`
+	Quota                      []SupportTicketQuota   `tfschema:"quota"`
+
+	Status          string `tfschema:"status"`
+	SupportPlanType string `tfschema:"support_plan_type"`
+	SupportTicketId string `tfschema:"support_ticket_id"`
+}
+
+type SupportTicketContact struct {
+	FirstName                string   `tfschema:"first_name"`
+	LastName                 string   `tfschema:"last_name"`
+	PreferredContactMethod   string   `tfschema:"preferred_contact_method"`
+	PrimaryEmailAddress      string   `tfschema:"primary_email_address"`
+	AdditionalEmailAddresses []string `tfschema:"additional_email_addresses"`
+	PhoneNumber              string   `tfschema:"phone_number"`
+	PreferredTimeZone        string   `tfschema:"preferred_time_zone"`
+	Country                  string   `tfschema:"country"`
+	PreferredSupportLanguage string   `tfschema:"preferred_support_language"`
+}
+
+type SupportTicketQuota struct {
+	RequestVersion string                            `tfschema:"request_version"`
+	RequestSubType string                            `tfschema:"request_sub_type"`
+	ChangeRequest  []SupportTicketQuotaChangeRequest `tfschema:"change_request"`
+}
+
+type SupportTicketQuotaChangeRequest struct {
+	Region  string `tfschema:"region"`
+	Payload string `tfschema:"payload"`
+}
+
+var _ sdk.ResourceWithUpdate = SupportTicketResource{}
+
+func (r SupportTicketResource) ModelObject() interface{} {
+	return &SupportTicketModel{}
+}
+
+func (r SupportTicketResource) ResourceType() string {
+	return "azurerm_support_ticket"
+}
+
+func (r SupportTicketResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SupportTicketID
+}
+
+func (r SupportTicketResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ServiceID,
+		},
+
+		"problem_classification_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ProblemClassificationID,
+		},
+
+		"severity": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(support.Minimal),
+				string(support.Moderate),
+				string(support.Critical),
+				string(support.Highestcriticalimpact),
+			}, false),
+		},
+
+		"contact": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"first_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"last_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"preferred_contact_method": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(support.PreferredContactMethodEmail),
+							string(support.PreferredContactMethodPhone),
+						}, false),
+					},
+
+					"primary_email_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"preferred_time_zone": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"country": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(3, 3),
+					},
+
+					"preferred_support_language": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"additional_email_addresses": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"phone_number": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"problem_start_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"require_24x7_response_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"technical_resource_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  azure.ValidateResourceID,
+			ConflictsWith: []string{"quota"},
+		},
+
+		"quota": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"technical_resource_id"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"change_request": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"region": {
+									Type:             pluginsdk.TypeString,
+									Required:         true,
+									ForceNew:         true,
+									ValidateFunc:     location.EnhancedValidate,
+									StateFunc:        location.StateFunc,
+									DiffSuppressFunc: location.DiffSuppressFunc,
+								},
+
+								"payload": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsJSON,
+								},
+							},
+						},
+					},
+
+					"request_sub_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"request_version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SupportTicketResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"support_plan_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"support_ticket_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SupportTicketResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.TicketsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config SupportTicketModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewSupportTicketID(subscriptionId, config.Name)
+
+			existing, err := client.Get(ctx, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := support.TicketDetails{
+				TicketDetailsProperties: &support.TicketDetailsProperties{
+					ContactDetails:          expandSupportTicketContact(config.Contact),
+					Description:             pointer.To(config.Description),
+					ProblemClassificationID: pointer.To(config.ProblemClassificationId),
+					QuotaTicketDetails:      expandSupportTicketQuota(config.Quota),
+					Require24X7Response:     pointer.To(config.Require24x7ResponseEnabled),
+					ServiceID:               pointer.To(config.ServiceId),
+					Severity:                support.SeverityLevel(config.Severity),
+					Title:                   pointer.To(config.Title),
+				},
+			}
+
+			if config.ProblemStartTime != "" {
+				problemStartTime, err := time.Parse(time.RFC3339, config.ProblemStartTime)
+				if err != nil {
+					return fmt.Errorf("parsing `problem_start_time`: %+v", err)
+				}
+				payload.TicketDetailsProperties.ProblemStartTime = &date.Time{Time: problemStartTime}
+			}
+
+			if config.TechnicalResourceId != "" {
+				payload.TicketDetailsProperties.TechnicalTicketDetails = &support.TechnicalTicketDetails{
+					ResourceID: pointer.To(config.TechnicalResourceId),
+				}
+			}
+
+			future, err := client.Create(ctx, id.Name, payload)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SupportTicketResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.TicketsClient
+
+			id, err := parse.SupportTicketID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SupportTicketModel{
+				Name: id.Name,
+			}
+
+			if props := resp.TicketDetailsProperties; props != nil {
+				state.Title = pointer.From(props.Title)
+				state.Description = pointer.From(props.Description)
+				state.Severity = string(props.Severity)
+				state.Contact = flattenSupportTicketContact(props.ContactDetails)
+				state.Require24x7ResponseEnabled = pointer.From(props.Require24X7Response)
+				state.Quota = flattenSupportTicketQuota(props.QuotaTicketDetails)
+				state.Status = pointer.From(props.Status)
+				state.SupportPlanType = pointer.From(props.SupportPlanType)
+				state.SupportTicketId = pointer.From(props.SupportTicketID)
+
+				if props.ProblemStartTime != nil {
+					state.ProblemStartTime = props.ProblemStartTime.Format(time.RFC3339)
+				}
+
+				serviceId, err := parse.ServiceIDInsensitively(pointer.From(props.ServiceID))
+				if err != nil {
+					return err
+				}
+				state.ServiceId = serviceId.ID()
+
+				problemClassificationId, err := parse.ProblemClassificationIDInsensitively(pointer.From(props.ProblemClassificationID))
+				if err != nil {
+					return err
+				}
+				state.ProblemClassificationId = problemClassificationId.ID()
+
+				if details := props.TechnicalTicketDetails; details != nil {
+					state.TechnicalResourceId = pointer.From(details.ResourceID)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SupportTicketResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.TicketsClient
+
+			id, err := parse.SupportTicketID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config SupportTicketModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := support.UpdateSupportTicket{}
+
+			if metadata.ResourceData.HasChange("severity") {
+				payload.Severity = support.SeverityLevel(config.Severity)
+			}
+
+			if metadata.ResourceData.HasChange("contact") {
+				contact := expandSupportTicketContact(config.Contact)
+				payload.ContactDetails = &support.UpdateContactProfile{
+					AdditionalEmailAddresses: contact.AdditionalEmailAddresses,
+					Country:                  contact.Country,
+					FirstName:                contact.FirstName,
+					LastName:                 contact.LastName,
+					PhoneNumber:              contact.PhoneNumber,
+					PreferredContactMethod:   contact.PreferredContactMethod,
+					PreferredSupportLanguage: contact.PreferredSupportLanguage,
+					PreferredTimeZone:        contact.PreferredTimeZone,
+					PrimaryEmailAddress:      contact.PrimaryEmailAddress,
+				}
+			}
+
+			if _, err := client.Update(ctx, id.Name, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SupportTicketResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Support.TicketsClient
+
+			id, err := parse.SupportTicketID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// Support Tickets can't be deleted, so the ticket is closed instead
+			if props := existing.TicketDetailsProperties; props != nil && strings.EqualFold(pointer.From(props.Status), string(support.Closed)) {
+				return nil
+			}
+
+			payload := support.UpdateSupportTicket{
+				Status: support.Closed,
+			}
+			if _, err := client.Update(ctx, id.Name, payload); err != nil {
+				return fmt.Errorf("closing %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSupportTicketContact(input []SupportTicketContact) *support.ContactProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	contact := input[0]
+	output := support.ContactProfile{
+		Country:                  pointer.To(contact.Country),
+		FirstName:                pointer.To(contact.FirstName),
+		LastName:                 pointer.To(contact.LastName),
+		PreferredContactMethod:   support.PreferredContactMethod(contact.PreferredContactMethod),
+		PreferredSupportLanguage: pointer.To(contact.PreferredSupportLanguage),
+		PreferredTimeZone:        pointer.To(contact.PreferredTimeZone),
+		PrimaryEmailAddress:      pointer.To(contact.PrimaryEmailAddress),
+	}
+
+	if len(contact.AdditionalEmailAddresses) > 0 {
+		output.AdditionalEmailAddresses = pointer.To(contact.AdditionalEmailAddresses)
+	}
+
+	if contact.PhoneNumber != "" {
+		output.PhoneNumber = pointer.To(contact.PhoneNumber)
+	}
+
+	return &output
+}
+
+func flattenSupportTicketContact(input *support.ContactProfile) []SupportTicketContact {
+	if input == nil {
+		return []SupportTicketContact{}
+	}
+
+	return []SupportTicketContact{
+		{
+			AdditionalEmailAddresses: pointer.From(input.AdditionalEmailAddresses),
+			Country:                  pointer.From(input.Country),
+			FirstName:                pointer.From(input.FirstName),
+			LastName:                 pointer.From(input.LastName),
+			PhoneNumber:              pointer.From(input.PhoneNumber),
+			PreferredContactMethod:   string(input.PreferredContactMethod),
+			PreferredSupportLanguage: pointer.From(input.PreferredSupportLanguage),
+			PreferredTimeZone:        pointer.From(input.PreferredTimeZone),
+			PrimaryEmailAddress:      pointer.From(input.PrimaryEmailAddress),
+		},
+	}
+}
+
+func expandSupportTicketQuota(input []SupportTicketQuota) *support.QuotaTicketDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	quota := input[0]
+	changeRequests := make([]support.QuotaChangeRequest, 0)
+	for _, v := range quota.ChangeRequest {
+		changeRequests = append(changeRequests, support.QuotaChangeRequest{
+			Payload: pointer.To(v.Payload),
+			Region:  pointer.To(location.Normalize(v.Region)),
+		})
+	}
+
+	output := support.QuotaTicketDetails{
+		QuotaChangeRequests: &changeRequests,
+	}
+
+	if quota.RequestSubType != "" {
+		output.QuotaChangeRequestSubType = pointer.To(quota.RequestSubType)
+	}
+
+	if quota.RequestVersion != "" {
+		output.QuotaChangeRequestVersion = pointer.To(quota.RequestVersion)
+	}
+
+	return &output
+}
+
+func flattenSupportTicketQuota(input *support.QuotaTicketDetails) []SupportTicketQuota {
+	if input == nil || input.QuotaChangeRequests == nil {
+		return []SupportTicketQuota{}
+	}
+
+	changeRequests := make([]SupportTicketQuotaChangeRequest, 0)
+	for _, v := range *input.QuotaChangeRequests {
+		changeRequests = append(changeRequests, SupportTicketQuotaChangeRequest{
+			Payload: pointer.From(v.Payload),
+			Region:  location.Normalize(pointer.From(v.Region)),
+		})
+	}
+
+	return []SupportTicketQuota{
+		{
+			ChangeRequest:  changeRequests,
+			RequestSubType: pointer.From(input.QuotaChangeRequestSubType),
+			RequestVersion: pointer.From(input.QuotaChangeRequestVersion),
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/support/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SupportTicketResource struct{}
+
+func TestAccSupportTicket_quota(t *testing.T) {
+	// filing a Support Ticket raises a real request with Azure Support, so this has to be explicitly opted into
+	if os.Getenv("ARM_TEST_SUPPORT_TICKET") == "" {
+		t.Skip("skipping tests - ARM_TEST_SUPPORT_TICKET was not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_support_ticket", "test")
+	r := SupportTicketResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.quota(data, "minimal"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("support_ticket_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.quota(data, "moderate"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (SupportTicketResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SupportTicketID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Support.TicketsClient.Get(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.TicketDetailsProperties != nil), nil
+}
+
+func (SupportTicketResource) quota(data acceptance.TestData, severity string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_support_service" "test" {
+  display_name = "Service and subscription limits (quotas)"
+}
+
+data "azurerm_support_problem_classification" "test" {
+  service_id   = data.azurerm_support_service.test.id
+  display_name = "Compute-VM (cores-vCPUs) subscription limit increases"
+}
+
+resource "azurerm_support_ticket" "test" {
+  name                      = "acctest-ticket-%[1]d"
+  title                     = "acctest quota increase %[1]d"
+  description               = "Acceptance test quota increase request - please close."
+  service_id                = data.azurerm_support_service.test.id
+  problem_classification_id = data.azurerm_support_problem_classification.test.id
+  severity                  = "%[3]s"
+
+  contact {
+    first_name                 = "Acceptance"
+    last_name                  = "Test"
+    preferred_contact_method   = "email"
+    primary_email_address      = "acctest@example.com"
+    preferred_time_zone        = "Pacific Standard Time"
+    country                    = "USA"
+    preferred_support_language = "en-US"
+  }
+
+  quota {
+    change_request {
+      region = "%[2]s"
+      payload = jsonencode({
+        SKU      = "DSv3 Series"
+        NewLimit = 104
+      })
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, severity)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestProblemClassificationID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// missing ServiceName
+			Input: "/providers/Microsoft.Support/",
+			Valid: false,
+		},
+
+		{
+			// missing value for ServiceName
+			Input: "/providers/Microsoft.Support/services/",
+			Valid: false,
+		},
+
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Support/services/service1/",
+			Valid: false,
+		},
+
+		{
+			// missing value for Name
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/",
+			Valid: false,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Support/services/service1/problemClassifications/problemClassification1",
+			Valid: true,
+		},
+
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.SUPPORT/SERVICES/SERVICE1/PROBLEMCLASSIFICATIONS/PROBLEMCLASSIFICATION1",
+			Valid: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ProblemClassificationID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -36,6 +37,9 @@ type CommunicationsGatewayModel struct {
 	ServiceLocation                    []ServiceRegionPropertiesModel                           `tfschema:"service_location"`
 	Tags                               map[string]string                                        `tfschema:"tags"`
 	MicrosoftTeamsVoicemailPilotNumber string                                                   `tfschema:"microsoft_teams_voicemail_pilot_number"`
+	AutoGeneratedDomainNameLabel       string                                                   `tfschema:"auto_generated_domain_name_label"`
+	ProvisioningState                  string                                                   `tfschema:"provisioning_state"`
+	Status                             string                                                   `tfschema:"status"`
 }
 
 type ServiceRegionPropertiesModel struct {
@@ -235,7 +239,22 @@ func (r CommunicationsGatewayResource) Arguments() map[string]*pluginsdk.Schema
 }
 
 func (r CommunicationsGatewayResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"auto_generated_domain_name_label": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r CommunicationsGatewayResource) Create() sdk.ResourceFunc {
@@ -436,6 +455,16 @@ func (r CommunicationsGatewayResource) Read() sdk.ResourceFunc {
 					state.AutoGeneratedDomainNameLabelScope = *properties.AutoGeneratedDomainNameLabelScope
 				}
 
+				state.AutoGeneratedDomainNameLabel = pointer.From(properties.AutoGeneratedDomainNameLabel)
+
+				if properties.ProvisioningState != nil {
+					state.ProvisioningState = string(*properties.ProvisioningState)
+				}
+
+				if properties.Status != nil {
+					state.Status = string(*properties.Status)
+				}
+
 				if properties.ApiBridge != nil && *properties.ApiBridge != nil {
 					apiBridgeValue, err := json.Marshal(*properties.ApiBridge)
 					if err != nil {
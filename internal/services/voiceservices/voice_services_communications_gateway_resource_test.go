@@ -27,6 +27,9 @@ func TestAccVoiceServicesCommunicationsGateway_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_generated_domain_name_label").IsNotEmpty(),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("status").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
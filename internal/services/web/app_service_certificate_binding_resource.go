@@ -4,6 +4,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,19 +28,48 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceCertificateBindingCreate,
 		Read:   resourceAppServiceCertificateBindingRead,
+		Update: resourceAppServiceCertificateBindingUpdate,
 		Delete: resourceAppServiceCertificateBindingDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.CertificateBindingID(id)
 			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			// `automatic_rotation_enabled` isn't returned by the API, so default it when importing
+			d.Set("automatic_rotation_enabled", true)
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.AppServiceCertificateBindingV0toV1{},
+		}),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// when the Certificate has been renewed (e.g. an App Service Managed Certificate) the thumbprint changes,
+			// so the binding needs to be rotated to use the new thumbprint
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				if d.Id() == "" || !d.Get("automatic_rotation_enabled").(bool) {
+					return nil
+				}
+
+				certificateThumbprint := d.Get("certificate_thumbprint").(string)
+				if certificateThumbprint != "" && certificateThumbprint != d.Get("thumbprint").(string) {
+					return d.SetNew("thumbprint", certificateThumbprint)
+				}
+
+				return nil
+			},
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"hostname_binding_id": {
 				Type:         pluginsdk.TypeString,
@@ -64,6 +95,12 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"automatic_rotation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"hostname": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -78,6 +115,11 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"certificate_thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -148,8 +190,51 @@ func resourceAppServiceCertificateBindingCreate(d *pluginsdk.ResourceData, meta
 	return resourceAppServiceCertificateBindingRead(d, meta)
 }
 
+func resourceAppServiceCertificateBindingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("thumbprint") {
+		certDetails, err := certClient.Get(ctx, id.CertificateId.ResourceGroup, id.CertificateId.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving App Service Certificate %q (Resource Group %q): %+v", id.CertificateId.Name, id.CertificateId.ResourceGroup, err)
+		}
+		if certDetails.Thumbprint == nil {
+			return fmt.Errorf("could not read thumbprint from certificate %q (resource group %q)", id.CertificateId.Name, id.CertificateId.ResourceGroup)
+		}
+
+		locks.ByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+		defer locks.UnlockByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+
+		binding, err := client.GetHostNameBinding(ctx, id.HostnameBindingId.ResourceGroup, id.HostnameBindingId.SiteName, id.HostnameBindingId.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving Custom Hostname Certificate Binding %q (App Service %q / Resource Group %q): %+v", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup, err)
+		}
+		if binding.HostNameBindingProperties == nil {
+			return fmt.Errorf("retrieving Custom Hostname Certificate Binding %q (App Service %q / Resource Group %q): `properties` was nil", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup)
+		}
+
+		log.Printf("[DEBUG] Rotating App Service Hostname Certificate Binding %q (App Service %q / Resource Group %q) to thumbprint %q", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup, *certDetails.Thumbprint)
+		binding.HostNameBindingProperties.Thumbprint = certDetails.Thumbprint
+
+		if _, err := client.CreateOrUpdateHostNameBinding(ctx, id.HostnameBindingId.ResourceGroup, id.HostnameBindingId.SiteName, id.HostnameBindingId.Name, binding); err != nil {
+			return fmt.Errorf("rotating Custom Hostname Certificate Binding %q with certificate name %q (App Service %q / Resource Group %q): %+v", id.HostnameBindingId.Name, id.CertificateId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup, err)
+		}
+	}
+
+	return resourceAppServiceCertificateBindingRead(d, meta)
+}
+
 func resourceAppServiceCertificateBindingRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -182,6 +267,17 @@ func resourceAppServiceCertificateBindingRead(d *pluginsdk.ResourceData, meta in
 	d.Set("hostname", id.HostnameBindingId.Name)
 	d.Set("app_service_name", id.HostnameBindingId.SiteName)
 
+	certificateThumbprint := ""
+	certDetails, err := certClient.Get(ctx, id.CertificateId.ResourceGroup, id.CertificateId.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certDetails.Response) {
+			return fmt.Errorf("retrieving App Service Certificate %q (Resource Group %q): %+v", id.CertificateId.Name, id.CertificateId.ResourceGroup, err)
+		}
+	} else if certDetails.CertificateProperties != nil && certDetails.Thumbprint != nil {
+		certificateThumbprint = *certDetails.Thumbprint
+	}
+	d.Set("certificate_thumbprint", certificateThumbprint)
+
 	return nil
 }
 
@@ -58,6 +58,40 @@ func TestAccAppServiceCertificateBinding_basicSniEnabled(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateBinding_automaticRotation(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_binding", "test")
+	r := AppServiceCertificateBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("automatic_rotation_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("certificate_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.automaticRotation(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("automatic_rotation_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("automatic_rotation_enabled"),
+		{
+			Config: r.automaticRotation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("automatic_rotation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServiceCertificateBinding_requiresImport(t *testing.T) {
 	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
 		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
@@ -146,6 +180,24 @@ resource "azurerm_app_service_certificate_binding" "test" {
 `, template)
 }
 
+func (t AppServiceCertificateBindingResource) automaticRotation(data acceptance.TestData, enabled bool) string {
+	template := t.testAccCertificateBinding_template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_app_service_certificate_binding" "test" {
+  hostname_binding_id        = azurerm_app_service_custom_hostname_binding.test.id
+  certificate_id             = azurerm_app_service_managed_certificate.test.id
+  ssl_state                  = "IpBasedEnabled"
+  automatic_rotation_enabled = %t
+}
+
+%s
+`, enabled, template)
+}
+
 func (t AppServiceCertificateBindingResource) requiresImport(data acceptance.TestData) string {
 	template := t.basic(data)
 	return fmt.Sprintf(`
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -17,10 +18,26 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	managedCertificateDomainValidationMethodCnameDelegation = "cname-delegation"
+	managedCertificateDomainValidationMethodHttpToken       = "http-token"
+)
+
+const (
+	managedCertificateRenewalStatusExpired        = "Expired"
+	managedCertificateRenewalStatusInvalid        = "Invalid"
+	managedCertificateRenewalStatusRenewalPending = "RenewalPending"
+	managedCertificateRenewalStatusValid          = "Valid"
+)
+
+// App Service Managed Certificates are automatically renewed by the platform 45 days prior to expiry
+const managedCertificateRenewalWindow = 45 * 24 * time.Hour
+
 func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceManagedCertificateCreateUpdate,
@@ -47,6 +64,18 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				ValidateFunc: validate.AppServiceCustomHostnameBindingID,
 			},
 
+			// NOTE: apex domains are validated using an A record rather than a CNAME, and as such require `http-token`
+			"domain_validation_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					managedCertificateDomainValidationMethodCnameDelegation,
+					managedCertificateDomainValidationMethodHttpToken,
+				}, false),
+			},
+
 			"canonical_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -90,6 +119,16 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"valid": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"renewal_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -156,6 +195,10 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		Tags:     tags.Expand(t),
 	}
 
+	if v := d.Get("domain_validation_method").(string); v != "" {
+		certificate.CertificateProperties.DomainValidationMethod = utils.String(v)
+	}
+
 	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
 		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
 		if !utils.ResponseWasStatusCode(resp.Response, 202) {
@@ -233,6 +276,12 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 		}
 		d.Set("expiration_date", expirationDate)
 		d.Set("thumbprint", props.Thumbprint)
+		d.Set("valid", props.Valid)
+		d.Set("renewal_status", managedCertificateRenewalStatus(props.Valid, props.ExpirationDate, time.Now()))
+
+		if props.DomainValidationMethod != nil {
+			d.Set("domain_validation_method", props.DomainValidationMethod)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -259,3 +308,20 @@ func resourceAppServiceManagedCertificateDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+// managedCertificateRenewalStatus returns the renewal status of the Managed Certificate, based on its validity and expiration date
+func managedCertificateRenewalStatus(valid *bool, expirationDate *date.Time, now time.Time) string {
+	if expirationDate != nil && !now.Before(expirationDate.Time) {
+		return managedCertificateRenewalStatusExpired
+	}
+
+	if valid != nil && !*valid {
+		return managedCertificateRenewalStatusInvalid
+	}
+
+	if expirationDate != nil && expirationDate.Time.Sub(now) <= managedCertificateRenewalWindow {
+		return managedCertificateRenewalStatusRenewalPending
+	}
+
+	return managedCertificateRenewalStatusValid
+}
@@ -33,6 +33,29 @@ func TestAccAppServiceManagedCertificate_basicLinux(t *testing.T) {
 			Config: r.basicLinux(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+				check.That(data.ResourceName).Key("renewal_status").HasValue("Valid"),
+				check.That(data.ResourceName).Key("expiration_date").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccAppServiceManagedCertificate_httpTokenValidation(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_certificate", "test")
+	r := AppServiceManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.httpTokenValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_method").HasValue("http-token"),
 			),
 		},
 	})
@@ -105,6 +128,18 @@ resource "azurerm_app_service_managed_certificate" "test" {
 `, template)
 }
 
+func (t AppServiceManagedCertificateResource) httpTokenValidation(data acceptance.TestData) string {
+	template := t.linuxTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_certificate" "test" {
+  custom_hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+  domain_validation_method   = "http-token"
+}
+`, template)
+}
+
 func (t AppServiceManagedCertificateResource) requiresImport(data acceptance.TestData) string {
 	template := t.basicLinux(data)
 	return fmt.Sprintf(`
@@ -5,6 +5,8 @@ package web
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
@@ -61,7 +63,7 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 	client := meta.(*clients.Client).Web.AppServicesClient
 	subnetClient := meta.(*clients.Client).Network.Client.Subnets
 	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	appID, err := parse.AppServiceID(d.Get("app_service_id").(string))
@@ -92,11 +94,28 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
+	virtualNetworkNames := []string{virtualNetworkName}
+	subnetNames := []string{subnetName}
 
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	// the connection is moved from the previous subnet to the new subnet in a single request, so that the App Service
+	// remains connected to the Virtual Network throughout - since this also updates the previous subnet it's locked too
+	var previousSubnetID *commonids.SubnetId
+	if !d.IsNewResource() && d.HasChange("subnet_id") {
+		oldSubnetID, _ := d.GetChange("subnet_id")
+		previousSubnetID, err = commonids.ParseSubnetID(oldSubnetID.(string))
+		if err != nil {
+			return err
+		}
+
+		virtualNetworkNames = append(virtualNetworkNames, previousSubnetID.VirtualNetworkName)
+		subnetNames = append(subnetNames, previousSubnetID.SubnetName)
+	}
+
+	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+
+	locks.MultipleByName(&subnetNames, network.SubnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetNames, network.SubnetResourceName)
 
 	exists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -128,6 +147,19 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 		return fmt.Errorf("waiting for provisioning state of subnet for App Service VNet association between %q (Resource Group %q) and Virtual Network %q: %s", name, resourceGroup, virtualNetworkName, err)
 	}
 
+	if previousSubnetID != nil {
+		previousStateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{string(subnets.ProvisioningStateUpdating)},
+			Target:     []string{string(subnets.ProvisioningStateSucceeded)},
+			Refresh:    network.SubnetProvisioningStateRefreshFunc(ctx, subnetClient, *previousSubnetID),
+			MinTimeout: 1 * time.Minute,
+			Timeout:    time.Until(timeout),
+		}
+		if _, err = previousStateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for provisioning state of the previous subnet %q for App Service VNet association of %q (Resource Group %q): %s", previousSubnetID.SubnetName, name, resourceGroup, err)
+		}
+	}
+
 	vnetId := commonids.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroupName, subnetID.VirtualNetworkName)
 	vnetStateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{string(subnets.ProvisioningStateUpdating)},
@@ -218,6 +250,13 @@ func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *pluginsdk.Resource
 		return nil
 	}
 
+	// when the connection has since been moved to another subnet (e.g. by a replacement created before this resource
+	// is destroyed) the App Service is left connected to that subnet, rather than being disconnected from the Virtual Network
+	if expected := d.Get("subnet_id").(string); expected != "" && !strings.EqualFold(*subnet, expected) {
+		log.Printf("[DEBUG] App Service VNet association %q is connected to the subnet %q rather than %q - skipping removal", d.Id(), *subnet, expected)
+		return nil
+	}
+
 	subnetID, err := commonids.ParseSubnetID(pointer.From(subnet))
 	if err != nil {
 		return fmt.Errorf("parsing Subnet Resource ID %q", subnetID)
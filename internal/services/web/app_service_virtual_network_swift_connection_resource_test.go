@@ -71,6 +71,29 @@ func TestAccAppServiceVirtualNetworkSwiftConnection_update(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceVirtualNetworkSwiftConnection_replaceSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_virtual_network_swift_connection", "test")
+	r := AppServiceVirtualNetworkSwiftConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.replaceSubnet(data, "acctestSubnet3", "10.0.3.0/24"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.replaceSubnet(data, "acctestSubnet4", "10.0.4.0/24"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet_id").MatchesOtherKey(check.That("azurerm_subnet.replace").Key("id")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServiceVirtualNetworkSwiftConnection_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_app_service_virtual_network_swift_connection", "test")
 	r := AppServiceVirtualNetworkSwiftConnectionResource{}
@@ -238,6 +261,38 @@ resource "azurerm_app_service_virtual_network_swift_connection" "test" {
 `, template)
 }
 
+func (AppServiceVirtualNetworkSwiftConnectionResource) replaceSubnet(data acceptance.TestData, subnetName string, addressPrefix string) string {
+	template := AppServiceVirtualNetworkSwiftConnectionResource{}.base(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "replace" {
+  name                 = "%s"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["%s"]
+
+  delegation {
+    name = "acctestdelegation"
+
+    service_delegation {
+      name    = "Microsoft.Web/serverFarms"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "azurerm_app_service_virtual_network_swift_connection" "test" {
+  app_service_id = azurerm_app_service.test.id
+  subnet_id      = azurerm_subnet.replace.id
+}
+`, template, subnetName, addressPrefix)
+}
+
 func (AppServiceVirtualNetworkSwiftConnectionResource) requiresImport(data acceptance.TestData) string {
 	template := AppServiceVirtualNetworkSwiftConnectionResource{}.basic(data)
 	return fmt.Sprintf(`
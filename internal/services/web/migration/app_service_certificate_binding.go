@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AppServiceCertificateBindingV0toV1 struct{}
+
+var _ pluginsdk.StateUpgrade = AppServiceCertificateBindingV0toV1{}
+
+func (a AppServiceCertificateBindingV0toV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"hostname_binding_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"ssl_state": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"app_service_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (a AppServiceCertificateBindingV0toV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// `automatic_rotation_enabled` isn't returned by the API, so existing bindings are upgraded to the default
+		// rather than showing a diff on the next plan
+		if _, ok := rawState["automatic_rotation_enabled"]; !ok {
+			log.Printf("[DEBUG] Setting `automatic_rotation_enabled` to `true` for App Service Certificate Binding %q", rawState["id"])
+			rawState["automatic_rotation_enabled"] = true
+		}
+		return rawState, nil
+	}
+}
@@ -5,6 +5,8 @@ package pluginsdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +16,33 @@ type IDValidationFunc func(id string) error
 
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+type importIdValidationOnlyKey struct{}
+
+// errImportIdValid is returned by Importers created using ImporterValidatingResourceId or
+// ImporterValidatingResourceIdThen when only the Resource ID is being validated and it's valid
+var errImportIdValid = errors.New("the Resource ID is valid")
+
+// ImporterValidatesResourceId returns whether the Importer accepts the specified Resource ID, without importing the
+// resource. This is only determinable for Importers created using ImporterValidatingResourceId or
+// ImporterValidatingResourceIdThen, for any other Importer false is returned.
+func ImporterValidatesResourceId(ctx context.Context, importer *schema.ResourceImporter, id string) (valid bool) {
+	if importer == nil || importer.StateContext == nil {
+		return false
+	}
+
+	// any other Importer imports the resource, which may require the (unavailable) client
+	defer func() {
+		if r := recover(); r != nil {
+			valid = false
+		}
+	}()
+
+	d := (&schema.Resource{}).Data(nil)
+	d.SetId(id)
+	_, err := importer.StateContext(context.WithValue(ctx, importIdValidationOnlyKey{}, true), d, nil)
+	return errors.Is(err, errImportIdValid)
+}
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -41,6 +70,10 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 				return []*ResourceData{d}, err
 			}
 
+			if validationOnly, ok := ctx.Value(importIdValidationOnlyKey{}).(bool); ok && validationOnly {
+				return nil, fmt.Errorf("%q: %w", d.Id(), errImportIdValid)
+			}
+
 			return thenFunc(ctx, d, meta)
 		},
 	}